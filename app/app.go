@@ -19,6 +19,7 @@
 package app // import "github.com/motki/core/app"
 
 import (
+	"io"
 	"io/ioutil"
 	"os"
 	"os/signal"
@@ -97,6 +98,7 @@ func NewClientEnv(conf *Config) (*ClientEnv, error) {
 		return nil, errors.New("app: cannot create client-only env with local grpc backend")
 	}
 	logger := log.New(conf.Logging)
+	log.SetDefault(logger)
 	work := worker.New(logger)
 	cl, err := client.New(conf.Backend, logger)
 	if err != nil {
@@ -176,6 +178,14 @@ func (env *ClientEnv) shutdownFuncs() []ShutdownFunc {
 			if err := env.Scheduler.Shutdown(); err != nil {
 				env.Logger.Warnf("app: error shutting down scheduler: %s", err.Error())
 			}
+		},
+		func() {
+			// Clients with a disk cache must be closed to release the file.
+			if c, ok := env.Client.(io.Closer); ok {
+				if err := c.Close(); err != nil {
+					env.Logger.Warnf("app: error closing client: %s", err.Error())
+				}
+			}
 		}}
 }
 
@@ -205,15 +215,31 @@ type Env struct {
 // NewEnv creates an Env using the given configuration.
 func NewEnv(conf *Config) (*Env, error) {
 	logger := log.New(conf.Logging)
+	log.SetDefault(logger)
 	pool, err := db.New(conf.Database, logger)
 	if err != nil {
 		return nil, errors.Wrap(err, "app: unable to initialize db connection pool")
 	}
+	if err = db.VerifySchema(pool, conf.Database, logger); err != nil {
+		return nil, errors.Wrap(err, "app: unable to verify db schema")
+	}
 	work := worker.New(logger)
 
 	ec := evemarketer.New()
 	edb := evedb.New(pool)
-	api := eveapi.New(conf.EVEAPI, logger)
+	var api *eveapi.EveAPI
+	if conf.EVEAPI.PersistentCache {
+		maxAge := time.Duration(conf.EVEAPI.CacheMaxAgeDays) * 24 * time.Hour
+		httpCache := eveapi.NewPostgresCache(pool, maxAge, logger)
+		api = eveapi.NewWithCache(conf.EVEAPI, logger, httpCache)
+		// Keep pruning after a failed run so that the cache cannot grow unbounded.
+		prune := work.RepeatFuncEvery(httpCache.Prune, time.Hour, worker.ContinueOnError())
+		if err = work.Schedule(worker.Named("prune-http-cache", prune)); err != nil {
+			return nil, errors.Wrap(err, "app: unable to schedule http cache pruning")
+		}
+	} else {
+		api = eveapi.New(conf.EVEAPI, logger)
+	}
 	mdl := model.NewManager(pool, edb, api, ec)
 
 	if conf.Backend.Kind == proto.BackendLocalGRPC {
@@ -271,5 +297,10 @@ func (env *Env) BlockUntilSignal(signals chan os.Signal) error {
 				env.Logger.Warnf("app: error shutting down grpc server: %s", err.Error())
 			}
 		}},
-		env.shutdownFuncs()...)...)
+		append(env.shutdownFuncs(), func() {
+			if env.DB == nil {
+				return
+			}
+			env.DB.Close()
+		})...)...)
 }
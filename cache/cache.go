@@ -6,16 +6,33 @@
 //
 // A cache bucket will remove expired entries at a regular interval in a separate
 // goroutine.
+//
+// A bucket created with NewWithCapacity is additionally bounded in size. When
+// adding a value would exceed its capacity, the least recently used values are
+// evicted to make room.
+//
+// Concurrent calls to Memoize for the same key are collapsed into a single call
+// of the expensive function.
+//
+// Values may be removed before they expire with Delete, by key prefix with
+// DeletePrefix, or by tag with InvalidateTag.
 package cache // import "github.com/motki/core/cache"
 
 import (
+	"container/list"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // The default interval between background removal of expired values.
 const ExpungeInterval = 60 * time.Second
 
+// errPanicked is returned to callers waiting on a memoized function that panicked.
+var errPanicked = errors.New("cache: memoized function panicked")
+
 // A Value is some cached value.
 type Value interface{}
 
@@ -26,10 +43,38 @@ func (c key) String() string {
 	return string(c)
 }
 
+// An EvictReason describes why a value was removed from the cache.
+type EvictReason int
+
+const (
+	Expired EvictReason = iota // The value's time-to-live elapsed.
+	Evicted                    // The value was evicted to stay within capacity.
+	Deleted                    // The value was explicitly deleted or invalidated.
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case Expired:
+		return "expired"
+	case Evicted:
+		return "evicted"
+	case Deleted:
+		return "deleted"
+	}
+	return "unknown"
+}
+
 // An item is one cached value and its metadata.
 type item struct {
+	key     key
 	value   Value
-	expires time.Time
+	err     error     // Error returned by a memoized function, if negatively cached.
+	expires time.Time // When the item is no longer fresh.
+	removes time.Time // When the item is no longer served at all.
+
+	cost int64         // Cost of the value, counted against the bucket's capacity.
+	elem *list.Element // Position in the bucket's LRU list, if bounded.
+	tags []string      // Tags the key is associated with.
 }
 
 // expired returns true if the cache item is expired.
@@ -37,23 +82,98 @@ func (c *item) expired() bool {
 	return time.Now().After(c.expires)
 }
 
+// dead returns true if the cache item can no longer be served, even as a stale value.
+func (c *item) dead() bool {
+	return time.Now().After(c.removes)
+}
+
+// A call is an in-flight or completed call of a memoized function.
+type call struct {
+	wg  sync.WaitGroup
+	val Value
+	err error
+}
+
+// Stats is a snapshot of a bucket's usage.
+type Stats struct {
+	Hits      int64 // Number of lookups that found a value, including stale values.
+	Misses    int64 // Number of lookups that found no value.
+	Evictions int64 // Number of values evicted to stay within capacity.
+	Expunges  int64 // Number of expired values removed.
+	Deletes   int64 // Number of values explicitly deleted or invalidated.
+
+	Len  int   // Number of values currently in the bucket.
+	Size int64 // Total cost of the values currently in the bucket.
+}
+
 // A Bucket contains cached items.
 type Bucket struct {
 	ttl   time.Duration
 	items map[key]*item
+	tags  map[string]map[key]struct{} // Keys associated with each tag.
+
+	// Usage counters, accessed atomically.
+	hits, misses, evictions, expunges, deletes int64
+
+	capacity int64      // Maximum total cost of all items, or 0 if unbounded.
+	size     int64      // Total cost of all items.
+	lru      *list.List // Items ordered from most to least recently used, if bounded.
 
 	mu   sync.RWMutex
 	quit chan struct{}
 	tag  func(k key, t time.Time)
+
+	calls   map[key]*call // In-flight memoized calls.
+	callsMu sync.Mutex    // Guards calls.
+
+	// Cost, if set, returns the cost of a value in a bounded bucket. By default,
+	// each value costs 1, making the capacity a limit on the number of values.
+	//
+	// Cost must be set before the bucket is used.
+	Cost func(v Value) int64
+
+	// OnEvict, if set, is called each time a value is removed from the bucket
+	// because it expired or was evicted. It is called without any locks held.
+	//
+	// OnEvict must be set before the bucket is used.
+	OnEvict func(k string, v Value, reason EvictReason)
+
+	// StaleTTL, if non-zero, enables stale-while-revalidate for Memoize. An
+	// expired value continues to be returned by Memoize for up to StaleTTL
+	// while a single goroutine refreshes it in the background. Get never
+	// returns expired values.
+	//
+	// StaleTTL must be set before the bucket is used.
+	StaleTTL time.Duration
+
+	// NegativeTTL, if non-zero, causes errors returned by memoized functions
+	// to be cached for NegativeTTL. Otherwise, errors are not cached.
+	//
+	// NegativeTTL must be set before the bucket is used.
+	NegativeTTL time.Duration
 }
 
 // New creates a new cache bucket with the configured time-to-live.
 func New(ttl time.Duration) *Bucket {
+	return NewWithCapacity(ttl, 0)
+}
+
+// NewWithCapacity creates a new cache bucket with the configured time-to-live
+// that holds values with a total cost of at most capacity.
+//
+// If capacity is zero or less, the bucket is unbounded.
+func NewWithCapacity(ttl time.Duration, capacity int64) *Bucket {
 	b := &Bucket{
 		ttl:   ttl,
 		items: make(map[key]*item),
+		tags:  make(map[string]map[key]struct{}),
 		mu:    sync.RWMutex{},
 		quit:  make(chan struct{}),
+		calls: make(map[key]*call),
+	}
+	if capacity > 0 {
+		b.capacity = capacity
+		b.lru = list.New()
 	}
 	exp := newExpunger(b)
 	go exp.processTags()
@@ -68,57 +188,277 @@ func (c *Bucket) Shutdown() error {
 	return nil
 }
 
-// Get returns the value stored for the given key or nil and false.
-func (c *Bucket) Get(ky string) (Value, bool) {
-	k := key(ky)
+// Stats returns a snapshot of the bucket's usage.
+func (c *Bucket) Stats() Stats {
 	c.mu.RLock()
-	it, ok := c.items[k]
+	n := len(c.items)
+	size := c.size
 	c.mu.RUnlock()
+	if c.lru == nil {
+		// Every value in an unbounded bucket costs 1.
+		size = int64(n)
+	}
+	return Stats{
+		Hits:      atomic.LoadInt64(&c.hits),
+		Misses:    atomic.LoadInt64(&c.misses),
+		Evictions: atomic.LoadInt64(&c.evictions),
+		Expunges:  atomic.LoadInt64(&c.expunges),
+		Deletes:   atomic.LoadInt64(&c.deletes),
+		Len:       n,
+		Size:      size,
+	}
+}
+
+// Get returns the value stored for the given key or nil and false.
+func (c *Bucket) Get(ky string) (Value, bool) {
+	it, fresh := c.lookup(key(ky))
+	if it == nil || !fresh || it.err != nil {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+	atomic.AddInt64(&c.hits, 1)
+	return it.value, true
+}
+
+// lookup returns the item stored for the given key, if it has not yet been
+// removed, and whether it is fresh.
+func (c *Bucket) lookup(k key) (*item, bool) {
+	var it *item
+	var ok bool
+	if c.lru != nil {
+		// Bounded buckets must record the access, requiring a write lock.
+		c.mu.Lock()
+		if it, ok = c.items[k]; ok && !it.dead() {
+			c.lru.MoveToFront(it.elem)
+		}
+		c.mu.Unlock()
+	} else {
+		c.mu.RLock()
+		it, ok = c.items[k]
+		c.mu.RUnlock()
+	}
 	if !ok {
 		return nil, false
 
-	} else if it.expired() {
+	} else if it.dead() {
 		c.remove(k)
 		return nil, false
 	}
-	return it.value, true
+	return it, !it.expired()
 }
 
 // Put writes the given value to the given key.
+//
+// If the bucket is bounded, the least recently used values are evicted until
+// the new value fits. A value that costs more than the bucket's entire
+// capacity is not stored.
 func (c *Bucket) Put(ky string, val Value) {
+	c.PutWithTTL(ky, val, c.ttl)
+}
+
+// PutWithTTL writes the given value to the given key, expiring after ttl
+// instead of the bucket's configured time-to-live.
+func (c *Bucket) PutWithTTL(ky string, val Value, ttl time.Duration) {
+	now := time.Now()
+	c.put(&item{
+		key:     key(ky),
+		value:   val,
+		expires: now.Add(ttl),
+		removes: now.Add(ttl + c.StaleTTL),
+	})
+}
+
+// put stores the given item, evicting other items if necessary.
+func (c *Bucket) put(it *item) {
+	var evicted []*item
+	defer func() {
+		c.notify(evicted, Evicted)
+	}()
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	expiry := time.Now().Add(c.ttl)
-	k := key(ky)
-	c.items[k] = &item{
-		value:   val,
-		expires: expiry,
+	old, replacing := c.items[it.key]
+	if replacing {
+		// Tags belong to the key rather than the value, so keep them.
+		it.tags = old.tags
+		c.unlink(old)
+	}
+	if c.lru != nil {
+		it.cost = 1
+		if it.err == nil {
+			it.cost = c.cost(it.value)
+		}
+		if it.cost > c.capacity {
+			if replacing {
+				evicted = append(evicted, old)
+			}
+			evicted = append(evicted, it)
+			return
+		}
+		for c.size+it.cost > c.capacity {
+			evicted = append(evicted, c.unlink(c.lru.Back().Value.(*item)))
+		}
 	}
-	c.tag(k, expiry)
+	c.link(it)
+	c.tag(it.key, it.removes)
 }
 
 // Memoize uses the cache to store the result of vfn to avoid repeating
 // relatively expensive operations for short periods.
-func (c *Bucket) Memoize(ky string, vfn func() (Value, error)) (v Value, err error) {
-	var ok bool
-	if v, ok = c.Get(ky); ok {
-		return v, nil
+//
+// If other goroutines call Memoize for the same key while vfn is running,
+// they wait for and share its result rather than calling their own vfn.
+func (c *Bucket) Memoize(ky string, vfn func() (Value, error)) (Value, error) {
+	k := key(ky)
+	it, fresh := c.lookup(k)
+	switch {
+	case it == nil:
+		// Not cached.
+
+	case fresh && it.err != nil:
+		atomic.AddInt64(&c.hits, 1)
+		return nil, it.err
+
+	case fresh:
+		atomic.AddInt64(&c.hits, 1)
+		return it.value, nil
+
+	case it.err == nil:
+		// Serve the stale value while refreshing it.
+		atomic.AddInt64(&c.hits, 1)
+		c.refresh(k, vfn)
+		return it.value, nil
+	}
+	atomic.AddInt64(&c.misses, 1)
+	return c.do(k, vfn, false)
+}
+
+// refresh calls vfn in a separate goroutine, unless a call is already in
+// flight for the given key.
+func (c *Bucket) refresh(k key, vfn func() (Value, error)) {
+	c.callsMu.Lock()
+	_, ok := c.calls[k]
+	c.callsMu.Unlock()
+	if ok {
+		return
+	}
+	go c.do(k, vfn, true)
+}
+
+// do calls vfn and caches its result, collapsing concurrent calls for the
+// same key into one.
+//
+// Errors are negatively cached only if NegativeTTL is set and this is not a
+// background refresh, in which case the stale value is kept instead.
+func (c *Bucket) do(k key, vfn func() (Value, error), background bool) (Value, error) {
+	c.callsMu.Lock()
+	if cl, ok := c.calls[k]; ok {
+		c.callsMu.Unlock()
+		cl.wg.Wait()
+		return cl.val, cl.err
 	}
+	cl := &call{err: errPanicked}
+	cl.wg.Add(1)
+	c.calls[k] = cl
+	c.callsMu.Unlock()
+
 	defer func() {
-		if err == nil {
-			c.Put(ky, v)
-		}
+		c.callsMu.Lock()
+		delete(c.calls, k)
+		c.callsMu.Unlock()
+		cl.wg.Done()
 	}()
-	return vfn()
+	cl.val, cl.err = vfn()
+	if cl.err == nil {
+		c.Put(k.String(), cl.val)
+	} else if c.NegativeTTL > 0 && !background {
+		now := time.Now()
+		c.put(&item{
+			key:     k,
+			err:     cl.err,
+			expires: now.Add(c.NegativeTTL),
+			removes: now.Add(c.NegativeTTL),
+		})
+	}
+	return cl.val, cl.err
+}
+
+// cost returns the cost of the given value.
+func (c *Bucket) cost(v Value) int64 {
+	if c.Cost == nil {
+		return 1
+	}
+	return c.Cost(v)
+}
+
+// link adds the item to the bucket. The bucket's lock must be held.
+func (c *Bucket) link(it *item) {
+	c.items[it.key] = it
+	if c.lru != nil {
+		it.elem = c.lru.PushFront(it)
+		c.size += it.cost
+	}
+	for _, t := range it.tags {
+		ks, ok := c.tags[t]
+		if !ok {
+			ks = make(map[key]struct{})
+			c.tags[t] = ks
+		}
+		ks[it.key] = struct{}{}
+	}
+}
+
+// unlink removes the item from the bucket. The bucket's lock must be held.
+func (c *Bucket) unlink(it *item) *item {
+	delete(c.items, it.key)
+	if it.elem != nil {
+		c.lru.Remove(it.elem)
+		c.size -= it.cost
+		it.elem = nil
+	}
+	for _, t := range it.tags {
+		if ks, ok := c.tags[t]; ok {
+			delete(ks, it.key)
+			if len(ks) == 0 {
+				delete(c.tags, t)
+			}
+		}
+	}
+	return it
 }
 
-// remove removes the given keys from the cache.
+// notify records the removal of the given items and calls the bucket's
+// OnEvict func, if set, for each of them.
+func (c *Bucket) notify(items []*item, reason EvictReason) {
+	n := int64(len(items))
+	switch reason {
+	case Expired:
+		atomic.AddInt64(&c.expunges, n)
+	case Evicted:
+		atomic.AddInt64(&c.evictions, n)
+	case Deleted:
+		atomic.AddInt64(&c.deletes, n)
+	}
+	if c.OnEvict == nil {
+		return
+	}
+	for _, it := range items {
+		c.OnEvict(it.key.String(), it.value, reason)
+	}
+}
+
+// remove removes the given keys from the cache if they can no longer be served.
+//
+// Keys that have since been written again with a later expiration are kept.
 func (c *Bucket) remove(keys ...key) {
+	var expired []*item
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	for _, k := range keys {
-		delete(c.items, k)
+		if it, ok := c.items[k]; ok && it.dead() {
+			expired = append(expired, c.unlink(it))
+		}
 	}
+	c.mu.Unlock()
+	c.notify(expired, Expired)
 }
 
 // expunger tracks items in the cache and removes expired values from
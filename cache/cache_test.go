@@ -1,9 +1,13 @@
 package cache_test
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/motki/core/cache"
 )
 
@@ -97,3 +101,285 @@ func TestMemoize(t *testing.T) {
 		return
 	}
 }
+
+func TestCapacity(t *testing.T) {
+	b := cache.NewWithCapacity(time.Second, 2)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+	var evicted []string
+	b.OnEvict = func(k string, v cache.Value, reason cache.EvictReason) {
+		if reason != cache.Evicted {
+			t.Errorf("expected reason evicted, got %s", reason)
+		}
+		evicted = append(evicted, k)
+	}
+
+	b.Put("a", 1)
+	b.Put("b", 2)
+	// Access "a" so that "b" is the least recently used.
+	if _, ok := b.Get("a"); !ok {
+		t.Errorf("expected value from cache, got nothing")
+		return
+	}
+	b.Put("c", 3)
+
+	if _, ok := b.Get("b"); ok {
+		t.Errorf("expected least recently used value to be evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := b.Get(k); !ok {
+			t.Errorf("expected value for %s from cache, got nothing", k)
+		}
+	}
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("expected only \"b\" to be evicted, got %v", evicted)
+	}
+}
+
+func TestCapacityCost(t *testing.T) {
+	b := cache.NewWithCapacity(time.Second, 10)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+	b.Cost = func(v cache.Value) int64 {
+		return int64(len(v.(string)))
+	}
+
+	b.Put("a", "aaaa")
+	b.Put("b", "bbbb")
+	b.Put("c", "cccc")
+	if _, ok := b.Get("a"); ok {
+		t.Errorf("expected oldest value to be evicted")
+	}
+	b.Put("d", "this value is too large")
+	if _, ok := b.Get("d"); ok {
+		t.Errorf("expected value larger than capacity not to be stored")
+	}
+	for _, k := range []string{"b", "c"} {
+		if _, ok := b.Get(k); !ok {
+			t.Errorf("expected value for %s from cache, got nothing", k)
+		}
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	b := cache.New(time.Second)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	var calls int64
+	release := make(chan struct{})
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := b.Memoize("test", func() (cache.Value, error) {
+				atomic.AddInt64(&calls, 1)
+				<-release
+				return 42, nil
+			})
+			if err != nil {
+				t.Errorf("error getting value from cache: %s", err.Error())
+				return
+			}
+			if v != 42 {
+				t.Errorf("expected 42, got %v", v)
+			}
+		}()
+	}
+	<-time.After(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt64(&calls); n != 1 {
+		t.Errorf("expected func to be called once, but was called %d times", n)
+	}
+}
+
+func TestMemoizeStale(t *testing.T) {
+	b := cache.New(10 * time.Millisecond)
+	b.StaleTTL = time.Second
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	var calls int64
+	refreshed := make(chan struct{})
+	get := func() (cache.Value, error) {
+		return b.Memoize("test", func() (cache.Value, error) {
+			n := atomic.AddInt64(&calls, 1)
+			if n > 1 {
+				defer close(refreshed)
+			}
+			return int(n), nil
+		})
+	}
+
+	if v, err := get(); err != nil || v != 1 {
+		t.Errorf("expected 1, got %v (err: %v)", v, err)
+		return
+	}
+
+	<-time.After(20 * time.Millisecond)
+
+	if _, ok := b.Get("test"); ok {
+		t.Errorf("expected Get not to return stale value")
+	}
+	if v, err := get(); err != nil || v != 1 {
+		t.Errorf("expected stale value 1, got %v (err: %v)", v, err)
+		return
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(250 * time.Millisecond):
+		t.Errorf("value was not refreshed in time")
+		return
+	}
+	for i := 0; ; i++ {
+		if v, ok := b.Get("test"); ok && v == 2 {
+			break
+		}
+		if i >= 10 {
+			t.Errorf("expected refreshed value to be cached")
+			return
+		}
+		<-time.After(time.Millisecond)
+	}
+}
+
+func TestMemoizeNegativeTTL(t *testing.T) {
+	b := cache.New(time.Second)
+	b.NegativeTTL = 10 * time.Millisecond
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	calls := new(int)
+	get := func() (cache.Value, error) {
+		return b.Memoize("test", func() (cache.Value, error) {
+			*calls++
+			return nil, errors.New("expensive call failed")
+		})
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := get(); err == nil {
+			t.Errorf("expected error, got none")
+			return
+		}
+	}
+	if *calls != 1 {
+		t.Errorf("expected func to be called once, but was called %d times", *calls)
+		return
+	}
+
+	<-time.After(20 * time.Millisecond)
+
+	if _, err := get(); err == nil {
+		t.Errorf("expected error, got none")
+		return
+	}
+	if *calls != 2 {
+		t.Errorf("expected func to be called again after negative TTL, but was called %d times", *calls)
+	}
+}
+
+func TestPutWithTTL(t *testing.T) {
+	b := cache.New(time.Second)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	b.PutWithTTL("short", 1, 10*time.Millisecond)
+	b.Put("long", 2)
+
+	<-time.After(20 * time.Millisecond)
+
+	if _, ok := b.Get("short"); ok {
+		t.Errorf("expected value with short TTL to expire")
+	}
+	if _, ok := b.Get("long"); !ok {
+		t.Errorf("expected value with default TTL from cache, got nothing")
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	b := cache.New(time.Second)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	b.Put("region:1", 1)
+	b.Put("region:2", 2)
+	b.Put("system:1", 3)
+	b.Put("system:2", 4)
+	b.Put("system:3", 5)
+	b.Tag("system:1", "sde")
+	b.Tag("system:2", "sde")
+
+	if !b.Delete("system:3") {
+		t.Errorf("expected value to be deleted")
+	}
+	if b.Delete("system:3") {
+		t.Errorf("expected nothing to be deleted")
+	}
+	if n := b.DeletePrefix("region:"); n != 2 {
+		t.Errorf("expected 2 values to be deleted, got %d", n)
+	}
+	// Tags are kept when a key is written again.
+	b.Put("system:1", 6)
+	if n := b.InvalidateTag("sde"); n != 2 {
+		t.Errorf("expected 2 values to be invalidated, got %d", n)
+	}
+	if n := b.InvalidateTag("sde"); n != 0 {
+		t.Errorf("expected no values to be invalidated, got %d", n)
+	}
+
+	st := b.Stats()
+	if st.Len != 0 || st.Size != 0 {
+		t.Errorf("expected empty bucket, got %d values with size %d", st.Len, st.Size)
+	}
+	if st.Deletes != 5 {
+		t.Errorf("expected 5 deletes, got %d", st.Deletes)
+	}
+}
+
+func TestStats(t *testing.T) {
+	b := cache.NewWithCapacity(10*time.Millisecond, 1)
+	defer func() {
+		if err := b.Shutdown(); err != nil {
+			t.Errorf("error shutting down bucket: %s", err.Error())
+		}
+	}()
+
+	b.Put("a", 1)
+	b.Get("a")
+	b.Get("b")
+	b.Put("b", 2)
+
+	<-time.After(20 * time.Millisecond)
+
+	b.Get("b")
+
+	expected := cache.Stats{Hits: 1, Misses: 2, Evictions: 1, Expunges: 1}
+	if st := b.Stats(); st != expected {
+		t.Errorf("expected %+v, got %+v", expected, st)
+	}
+}
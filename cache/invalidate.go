@@ -0,0 +1,87 @@
+package cache
+
+import "strings"
+
+// Delete removes the value stored for the given key, returning true if a
+// value was removed.
+func (c *Bucket) Delete(ky string) bool {
+	c.mu.Lock()
+	it, ok := c.items[key(ky)]
+	if ok {
+		c.unlink(it)
+	}
+	c.mu.Unlock()
+	if ok {
+		c.notify([]*item{it}, Deleted)
+	}
+	return ok
+}
+
+// DeletePrefix removes all values whose keys begin with prefix, returning the
+// number of values removed.
+//
+// For example, DeletePrefix("region:") removes every cached region.
+func (c *Bucket) DeletePrefix(prefix string) int {
+	var deleted []*item
+	c.mu.Lock()
+	for k, it := range c.items {
+		if strings.HasPrefix(k.String(), prefix) {
+			deleted = append(deleted, c.unlink(it))
+		}
+	}
+	c.mu.Unlock()
+	c.notify(deleted, Deleted)
+	return len(deleted)
+}
+
+// Tag associates the given key with one or more tags, allowing it to be
+// removed later with InvalidateTag.
+//
+// Tags belong to the key, not its current value; they are kept when the key
+// is written again and dropped when the key is removed. Tagging a key with
+// no value has no effect.
+func (c *Bucket) Tag(ky string, tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	it, ok := c.items[key(ky)]
+	if !ok {
+		return
+	}
+	for _, t := range tags {
+		if hasTag(it.tags, t) {
+			continue
+		}
+		it.tags = append(it.tags, t)
+		ks, ok := c.tags[t]
+		if !ok {
+			ks = make(map[key]struct{})
+			c.tags[t] = ks
+		}
+		ks[it.key] = struct{}{}
+	}
+}
+
+// InvalidateTag removes all values whose keys are associated with the given
+// tag, returning the number of values removed.
+func (c *Bucket) InvalidateTag(tag string) int {
+	var deleted []*item
+	c.mu.Lock()
+	for k := range c.tags[tag] {
+		if it, ok := c.items[k]; ok {
+			deleted = append(deleted, c.unlink(it))
+		}
+	}
+	c.mu.Unlock()
+	c.notify(deleted, Deleted)
+	return len(deleted)
+}
+
+// hasTag returns true if tags contains t.
+func hasTag(tags []string, t string) bool {
+	for _, v := range tags {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,11 @@
 package db // import "github.com/motki/core/db"
 
 import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
 	"github.com/jackc/pgx"
 
 	"github.com/motki/core/log"
@@ -14,6 +19,24 @@ import (
 type Config struct {
 	ConnString     string `toml:"connection_string"`
 	MaxConnections int    `toml:"max_connections"`
+
+	// Path to the directory containing schema migrations, such as resources/ddl.
+	// If set, the schema is verified to be up to date at startup.
+	MigrationsPath string `toml:"migrations_path"`
+	// If enabled, pending migrations are applied at startup.
+	AutoMigrate bool `toml:"auto_migrate"`
+
+	// Queries taking longer than this many milliseconds are logged as a
+	// warning. Slow query logging is disabled if zero.
+	SlowQueryMillis int `toml:"slow_query_ms"`
+
+	// Connection strings for read-only replicas of the database, such as a
+	// hot standby. Read-only queries are spread across healthy replicas,
+	// falling back to the primary if none are available. Replicas are used
+	// once they pass their first health check, shortly after startup.
+	Replicas []string `toml:"replicas"`
+	// How often, in seconds, replicas are checked for health. Defaults to 30.
+	HealthCheckInterval int `toml:"health_check_interval"`
 }
 
 // New creates a new ConnPool using the given Config.
@@ -27,16 +50,82 @@ func New(c Config, l log.Logger) (*ConnPool, error) {
 	if err != nil {
 		return nil, err
 	}
+	t := &tracer{}
+	pcon.Logger = t
+	pcon.LogLevel = pgx.LogLevelInfo
 	p, err := pgx.NewConnPool(pgx.ConnPoolConfig{ConnConfig: pcon, MaxConnections: c.MaxConnections})
 	if err != nil {
 		return nil, err
 	}
-	return &ConnPool{p}, nil
+	if c.SlowQueryMillis > 0 {
+		t.add(SlowQueryLogger(l, time.Duration(c.SlowQueryMillis)*time.Millisecond))
+	}
+	res := &ConnPool{
+		pool:     p,
+		tracer:   t,
+		logger:   l,
+		borrowed: make(map[*pgx.Conn]*replica),
+		done:     make(chan struct{}),
+	}
+	for _, rs := range c.Replicas {
+		rcon, err := pgx.ParseConnectionString(rs)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		if rcon.Port == 0 {
+			rcon.Port = 5432
+		}
+		rcon.Logger = t
+		rcon.LogLevel = pgx.LogLevelInfo
+		if rcon.Dial == nil {
+			// Without a timeout, connecting to an unreachable replica
+			// would stall the health check indefinitely.
+			rcon.Dial = (&net.Dialer{Timeout: healthCheckTimeout, KeepAlive: 5 * time.Minute}).Dial
+		}
+		r := &replica{
+			conf: pgx.ConnPoolConfig{ConnConfig: rcon, MaxConnections: c.MaxConnections},
+			name: fmt.Sprintf("%s:%d", rcon.Host, rcon.Port),
+		}
+		l.Debugf("db: init replica connection pool for: %s", r.name)
+		res.replicas = append(res.replicas, r)
+	}
+	if len(res.replicas) > 0 {
+		interval := defaultHealthCheckInterval
+		if c.HealthCheckInterval > 0 {
+			interval = time.Duration(c.HealthCheckInterval) * time.Second
+		}
+		// Replicas are first checked in the background so that an
+		// unreachable replica does not delay startup.
+		go func() {
+			res.checkReplicas()
+			for _, r := range res.replicas {
+				if !r.isHealthy() {
+					l.Warnf("db: replica %s is unavailable, it will be used once healthy", r.name)
+				}
+			}
+			res.healthCheck(interval)
+		}()
+	}
+	return res, nil
 }
 
 // Type ConnPool represents a connection pool.
+//
+// A ConnPool may also contain pools for any number of read-only replicas.
+// See OpenRead for details.
 type ConnPool struct {
-	pool *pgx.ConnPool
+	pool   *pgx.ConnPool
+	tracer *tracer
+	logger log.Logger
+
+	replicas []*replica
+
+	mu       sync.Mutex
+	next     int                    // Index of the next replica to use.
+	borrowed map[*pgx.Conn]*replica // Replica each open replica connection belongs to.
+
+	done chan struct{}
 }
 
 // Open acquires a connection for the caller.
@@ -54,5 +143,26 @@ func (p *ConnPool) Open() (*pgx.Conn, error) {
 // of any function that Opens a connection. If a connection is not released,
 // eventually the application will run out of available connections and fail.
 func (p *ConnPool) Release(c *pgx.Conn) {
+	if len(p.replicas) > 0 {
+		p.mu.Lock()
+		r, ok := p.borrowed[c]
+		delete(p.borrowed, c)
+		p.mu.Unlock()
+		if ok {
+			r.pool.Release(c)
+			return
+		}
+	}
 	p.pool.Release(c)
 }
+
+// Close stops checking the health of replicas and closes all connections.
+//
+// Connections that are in use are closed when they are released.
+func (p *ConnPool) Close() {
+	close(p.done)
+	for _, r := range p.replicas {
+		r.close()
+	}
+	p.pool.Close()
+}
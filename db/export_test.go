@@ -0,0 +1,26 @@
+package db
+
+import "github.com/jackc/pgx"
+
+// CheckReplicas checks the health of each replica immediately.
+func (p *ConnPool) CheckReplicas() {
+	p.checkReplicas()
+}
+
+// Borrowed returns the number of replica connections that are in use.
+func (p *ConnPool) Borrowed() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.borrowed)
+}
+
+// IsRetryable exposes isRetryable to tests.
+var IsRetryable = isRetryable
+
+// TraceLog logs a query with the given message and data to a tracer with qt
+// registered, as pgx would.
+func TraceLog(qt QueryTracer, msg string, data map[string]interface{}) {
+	t := &tracer{}
+	t.add(qt)
+	t.Log(pgx.LogLevelInfo, msg, data)
+}
@@ -0,0 +1,386 @@
+package db
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+
+	"github.com/motki/core/log"
+)
+
+var (
+	// ErrSchemaOutOfDate is returned by Verify when migrations are pending.
+	ErrSchemaOutOfDate = errors.New("db: schema is out of date")
+
+	// ErrChecksumMismatch is returned when an applied migration has been
+	// modified since it was applied.
+	ErrChecksumMismatch = errors.New("db: applied migration has been modified")
+
+	// ErrDestructiveMigration is returned when applying a migration would drop
+	// a table that already contains data.
+	ErrDestructiveMigration = errors.New("db: migration would drop a table containing data")
+)
+
+// migrationsLockID identifies the advisory lock held while applying migrations.
+const migrationsLockID = 0x6d6f746b69
+
+// A Migration is a single, versioned schema change.
+//
+// Migrations are loaded from files named in the form "NNN-name.sql", where NNN
+// is the version number. Migrations are applied in order of version.
+type Migration struct {
+	Version  int
+	Name     string
+	SQL      string
+	Checksum string // Hex encoded SHA-256 of SQL.
+}
+
+// migrationFile matches valid migration file names.
+var migrationFile = regexp.MustCompile(`^(\d+)-([\w-]+)\.sql$`)
+
+// LoadMigrations reads all migrations in the given directory, ordered by version.
+//
+// Files that do not match the migration naming scheme are ignored.
+func LoadMigrations(dir string) ([]*Migration, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var res []*Migration
+	seen := make(map[int]string)
+	for _, f := range files {
+		m := migrationFile.FindStringSubmatch(f.Name())
+		if f.IsDir() || m == nil {
+			continue
+		}
+		v, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "db: invalid migration version in %s", f.Name())
+		}
+		if other, ok := seen[v]; ok {
+			return nil, errors.Errorf("db: migrations %s and %s have the same version", other, f.Name())
+		}
+		seen[v] = f.Name()
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(b)
+		res = append(res, &Migration{
+			Version:  v,
+			Name:     m[2],
+			SQL:      string(b),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Version < res[j].Version
+	})
+	return res, nil
+}
+
+// String returns the file name of the migration.
+func (m *Migration) String() string {
+	return strconv.Itoa(m.Version) + "-" + m.Name
+}
+
+// dropTable matches DROP TABLE statements, capturing the table names.
+var dropTable = regexp.MustCompile(`(?i)DROP\s+TABLE\s+(?:IF\s+EXISTS\s+)?([\w."]+(?:\s*,\s*[\w."]+)*)`)
+
+// droppedTables returns the names of all tables dropped by the migration.
+func (m *Migration) droppedTables() []string {
+	var res []string
+	for _, match := range dropTable.FindAllStringSubmatch(m.SQL, -1) {
+		for _, t := range strings.Split(match[1], ",") {
+			res = append(res, strings.TrimSpace(t))
+		}
+	}
+	return res
+}
+
+// A Migrator applies migrations to a database, tracking the applied versions
+// in the schema_migrations table.
+//
+// Each migration is applied only once. Once applied, a migration's checksum is
+// recorded, and any later changes to the migration are reported as an error
+// rather than re-applied.
+type Migrator struct {
+	pool       *ConnPool
+	logger     log.Logger
+	migrations []*Migration
+
+	// If DryRun is true, Migrate applies pending migrations in a single
+	// transaction that is then rolled back, leaving the database unchanged.
+	DryRun bool
+}
+
+// NewMigrator creates a new Migrator for the given migrations.
+func NewMigrator(p *ConnPool, l log.Logger, migrations []*Migration) *Migrator {
+	return &Migrator{pool: p, logger: l, migrations: migrations}
+}
+
+// init creates the schema_migrations table if it does not exist.
+func (m *Migrator) init(c *pgx.Conn) error {
+	_, err := c.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations
+		(
+		  version INT PRIMARY KEY NOT NULL,
+		  name VARCHAR(255) NOT NULL,
+		  checksum VARCHAR(64) NOT NULL,
+		  applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+		)`)
+	return err
+}
+
+// applied returns the checksum of each applied migration, by version.
+func (m *Migrator) applied(c *pgx.Conn) (map[int]string, error) {
+	if err := m.init(c); err != nil {
+		return nil, err
+	}
+	rs, err := c.Query(`SELECT version, checksum FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	res := make(map[int]string)
+	for rs.Next() {
+		var v int32
+		var sum string
+		if err := rs.Scan(&v, &sum); err != nil {
+			return nil, err
+		}
+		res[int(v)] = sum
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// pending returns the migrations that have not been applied, verifying the
+// checksums of those that have.
+func (m *Migrator) pending(c *pgx.Conn) ([]*Migration, error) {
+	applied, err := m.applied(c)
+	if err != nil {
+		return nil, err
+	}
+	var res []*Migration
+	for _, mig := range m.migrations {
+		sum, ok := applied[mig.Version]
+		if !ok {
+			res = append(res, mig)
+			continue
+		}
+		if sum != mig.Checksum {
+			return nil, errors.Wrap(ErrChecksumMismatch, mig.String())
+		}
+	}
+	return res, nil
+}
+
+// Pending returns the migrations that have not yet been applied.
+//
+// ErrChecksumMismatch is returned if any applied migration has been modified.
+func (m *Migrator) Pending() ([]*Migration, error) {
+	c, err := m.pool.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer m.pool.Release(c)
+	return m.pending(c)
+}
+
+// Verify returns an error if the schema is not up to date.
+func (m *Migrator) Verify() error {
+	p, err := m.Pending()
+	if err != nil {
+		return err
+	}
+	if len(p) > 0 {
+		return errors.Wrapf(ErrSchemaOutOfDate, "%d pending migrations, next is %s", len(p), p[0])
+	}
+	return nil
+}
+
+// Migrate applies all pending migrations in order, returning those applied.
+//
+// Each migration is applied in its own transaction. If a migration fails,
+// it is rolled back and no further migrations are applied. A migration that
+// would drop a table that already contains data is refused with
+// ErrDestructiveMigration; use Baseline to record such migrations as applied
+// when adopting an existing database.
+func (m *Migrator) Migrate() ([]*Migration, error) {
+	c, err := m.pool.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer m.pool.Release(c)
+	pending, err := m.pending(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+	if m.DryRun {
+		return pending, m.dryRun(c, pending)
+	}
+	var res []*Migration
+	for _, mig := range pending {
+		if err := m.apply(c, mig); err != nil {
+			return res, err
+		}
+		res = append(res, mig)
+	}
+	return res, nil
+}
+
+// dryRun applies the given migrations in a single transaction, then rolls back.
+func (m *Migrator) dryRun(c *pgx.Conn, migs []*Migration) error {
+	tx, err := c.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, mig := range migs {
+		m.logger.Infof("db: dry run: applying migration %s", mig)
+		if err := m.exec(tx, mig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply applies the given migration in a transaction.
+func (m *Migrator) apply(c *pgx.Conn, mig *Migration) error {
+	tx, err := c.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	m.logger.Infof("db: applying migration %s", mig)
+	if err = m.exec(tx, mig); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// exec runs the migration and records it as applied within the given transaction.
+func (m *Migrator) exec(tx *pgx.Tx, mig *Migration) error {
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1)`, migrationsLockID); err != nil {
+		return err
+	}
+	var exists bool
+	err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE version = $1)`, mig.Version).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if exists {
+		// Applied concurrently by another process.
+		return nil
+	}
+	if err = m.checkDestructive(tx, mig); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(mig.SQL); err != nil {
+		return errors.Wrapf(err, "db: unable to apply migration %s", mig)
+	}
+	return m.record(tx, mig)
+}
+
+// record marks the migration as applied.
+func (m *Migrator) record(tx *pgx.Tx, mig *Migration) error {
+	_, err := tx.Exec(
+		`INSERT INTO schema_migrations (version, name, checksum) VALUES($1, $2, $3)`,
+		mig.Version, mig.Name, mig.Checksum)
+	return err
+}
+
+// checkDestructive returns ErrDestructiveMigration if the migration drops a
+// table that contains data.
+func (m *Migrator) checkDestructive(tx *pgx.Tx, mig *Migration) error {
+	for _, t := range mig.droppedTables() {
+		var exists bool
+		if err := tx.QueryRow(`SELECT TO_REGCLASS($1) IS NOT NULL`, t).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		var hasRows bool
+		// The table name comes from the migration itself and is known to exist.
+		if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM ` + t + `)`).Scan(&hasRows); err != nil {
+			return err
+		}
+		if hasRows {
+			return errors.Wrapf(ErrDestructiveMigration, "%s drops %s", mig, t)
+		}
+	}
+	return nil
+}
+
+// Baseline records all migrations up to and including the given version as
+// applied without running them.
+//
+// Use Baseline when adopting a database whose schema was created manually.
+func (m *Migrator) Baseline(version int) error {
+	c, err := m.pool.Open()
+	if err != nil {
+		return err
+	}
+	defer m.pool.Release(c)
+	pending, err := m.pending(c)
+	if err != nil {
+		return err
+	}
+	tx, err := c.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, mig := range pending {
+		if mig.Version > version {
+			break
+		}
+		m.logger.Infof("db: recording migration %s as applied", mig)
+		if err = m.record(tx, mig); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// VerifySchema ensures the database schema is up to date with the migrations
+// in the configured MigrationsPath.
+//
+// If AutoMigrate is enabled, pending migrations are applied. Otherwise,
+// ErrSchemaOutOfDate is returned if any migrations are pending. Nothing is
+// checked if no MigrationsPath is configured.
+func VerifySchema(p *ConnPool, c Config, l log.Logger) error {
+	if c.MigrationsPath == "" {
+		return nil
+	}
+	migs, err := LoadMigrations(c.MigrationsPath)
+	if err != nil {
+		return err
+	}
+	m := NewMigrator(p, l, migs)
+	if !c.AutoMigrate {
+		return m.Verify()
+	}
+	applied, err := m.Migrate()
+	if err != nil {
+		return err
+	}
+	if len(applied) > 0 {
+		l.Infof("db: applied %d migrations", len(applied))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package db_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/motki/core/db"
+)
+
+// TestLoadMigrations tests that the schema in resources/ddl loads in order.
+func TestLoadMigrations(t *testing.T) {
+	migs, err := db.LoadMigrations("../resources/ddl")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(migs) == 0 {
+		t.Errorf("expected migrations, got none")
+		return
+	}
+	if migs[0].String() != "1-schema" {
+		t.Errorf("expected first migration to be 1-schema, got %s", migs[0])
+	}
+	for i := 1; i < len(migs); i++ {
+		if migs[i].Version <= migs[i-1].Version {
+			t.Errorf("expected %s to come before %s", migs[i-1], migs[i])
+		}
+		if len(migs[i].Checksum) != 64 {
+			t.Errorf("expected sha-256 checksum for %s, got %q", migs[i], migs[i].Checksum)
+		}
+	}
+}
+
+// TestLoadMigrationsDuplicate tests that two migrations may not share a version.
+func TestLoadMigrationsDuplicate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"001-a.sql", "1-b.sql", "README.md"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0600); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+	}
+	if _, err := db.LoadMigrations(dir); err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
@@ -0,0 +1,166 @@
+package db
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// defaultHealthCheckInterval is used when Config.HealthCheckInterval is not set.
+const defaultHealthCheckInterval = 30 * time.Second
+
+// healthCheckTimeout is the maximum time a replica may take to respond to a
+// health check.
+const healthCheckTimeout = 5 * time.Second
+
+// errReplicaClosed is returned when checking a replica after the pool is closed.
+var errReplicaClosed = errors.New("db: replica is closed")
+
+// replica is a connection pool for a read-only replica of the database.
+type replica struct {
+	conf pgx.ConnPoolConfig
+	name string // Host and port, for logging.
+
+	mu      sync.Mutex
+	pool    *pgx.ConnPool // Nil until a connection is first established.
+	healthy bool
+	closed  bool
+}
+
+// acquire returns a connection from the replica if it is healthy.
+func (r *replica) acquire() (*pgx.Conn, bool) {
+	r.mu.Lock()
+	pool, healthy := r.pool, r.healthy
+	r.mu.Unlock()
+	if !healthy {
+		return nil, false
+	}
+	c, err := pool.Acquire()
+	if err != nil {
+		return nil, false
+	}
+	return c, true
+}
+
+// check tests the replica, connecting first if necessary.
+func (r *replica) check() error {
+	r.mu.Lock()
+	pool := r.pool
+	r.mu.Unlock()
+	if pool == nil {
+		p, err := pgx.NewConnPool(r.conf)
+		if err != nil {
+			return err
+		}
+		r.mu.Lock()
+		if r.closed || r.pool != nil {
+			// Closed or connected by a concurrent check.
+			p.Close()
+		} else {
+			r.pool = p
+		}
+		pool = r.pool
+		r.mu.Unlock()
+		if pool == nil {
+			return errReplicaClosed
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	_, err := pool.ExecEx(ctx, `SELECT 1`, nil)
+	return err
+}
+
+// isHealthy returns true if the replica passed its last health check.
+func (r *replica) isHealthy() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.healthy
+}
+
+// setHealthy updates the health of the replica, returning true if it changed.
+//
+// Existing connections are discarded when a replica becomes unhealthy.
+func (r *replica) setHealthy(healthy bool) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.healthy == healthy {
+		return false
+	}
+	r.healthy = healthy
+	if !healthy && r.pool != nil {
+		r.pool.Reset()
+	}
+	return true
+}
+
+// close closes the replica's connections.
+func (r *replica) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	if r.pool != nil {
+		r.pool.Close()
+	}
+}
+
+// OpenRead acquires a connection for the caller to perform read-only queries.
+//
+// If any replicas are configured, the connection is taken from the next
+// healthy replica. If no replica is available, a connection to the primary is
+// returned. The connection must be returned with Release, as with Open.
+func (p *ConnPool) OpenRead() (*pgx.Conn, error) {
+	n := len(p.replicas)
+	if n == 0 {
+		return p.Open()
+	}
+	p.mu.Lock()
+	start := p.next
+	p.next = (p.next + 1) % n
+	p.mu.Unlock()
+	for i := 0; i < n; i++ {
+		r := p.replicas[(start+i)%n]
+		if c, ok := r.acquire(); ok {
+			p.mu.Lock()
+			p.borrowed[c] = r
+			p.mu.Unlock()
+			return c, nil
+		}
+	}
+	return p.Open()
+}
+
+// checkReplicas checks the health of each replica.
+//
+// Replicas that fail a check are ejected and receive no queries until they
+// pass a later check.
+func (p *ConnPool) checkReplicas() {
+	for _, r := range p.replicas {
+		if err := r.check(); err != nil {
+			if r.setHealthy(false) {
+				p.logger.Warnf("db: ejecting unhealthy replica %s: %s", r.name, err.Error())
+			}
+			continue
+		}
+		if r.setHealthy(true) {
+			p.logger.Infof("db: replica %s is healthy", r.name)
+		}
+	}
+}
+
+// healthCheck checks the replicas at the given interval until the pool is closed.
+func (p *ConnPool) healthCheck(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-t.C:
+			p.checkReplicas()
+		}
+	}
+}
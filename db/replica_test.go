@@ -0,0 +1,140 @@
+package db_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/log"
+)
+
+// withAppName returns the connection string with the given application name,
+// which is used to tell connections to the primary and each replica apart.
+func withAppName(conn, name string) string {
+	if !strings.Contains(conn, "://") {
+		return conn + " application_name=" + name
+	}
+	if strings.Contains(conn, "?") {
+		return conn + "&application_name=" + name
+	}
+	return conn + "?application_name=" + name
+}
+
+// appName returns the application name of the given connection.
+func appName(t *testing.T, c *pgx.Conn) string {
+	var name string
+	if err := c.QueryRow(`SHOW application_name`).Scan(&name); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return name
+}
+
+// newReplicaPool returns a ConnPool using the test database as the primary
+// and the given replicas, after checking the health of the replicas.
+func newReplicaPool(t *testing.T, replicas ...string) *db.ConnPool {
+	conn := dbtest.ConnString(t)
+	p, err := db.New(db.Config{
+		ConnString:     withAppName(conn, "primary"),
+		MaxConnections: 2,
+		Replicas:       replicas,
+	}, log.New(log.Config{Level: "fatal"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	p.CheckReplicas()
+	return p
+}
+
+// openRead opens a read-only connection and returns its application name
+// after releasing it.
+func openRead(t *testing.T, p *db.ConnPool) string {
+	c, err := p.OpenRead()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	return appName(t, c)
+}
+
+// TestOpenRead tests that read-only connections alternate between healthy
+// replicas.
+func TestOpenRead(t *testing.T) {
+	conn := dbtest.ConnString(t)
+	p := newReplicaPool(t, withAppName(conn, "replica1"), withAppName(conn, "replica2"))
+	defer p.Close()
+
+	seen := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		seen[openRead(t, p)]++
+	}
+	if seen["replica1"] != 2 || seen["replica2"] != 2 {
+		t.Errorf("expected 2 connections to each replica, got %v", seen)
+	}
+
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	if name := appName(t, c); name != "primary" {
+		t.Errorf("expected Open to use the primary, got %s", name)
+	}
+}
+
+// TestOpenReadFailover tests that the primary is used when no replica is
+// healthy, and that unhealthy replicas are skipped.
+func TestOpenReadFailover(t *testing.T) {
+	conn := dbtest.ConnString(t)
+	// Nothing listens on port 1, so connections are refused immediately.
+	down := "postgres://motki@127.0.0.1:1/motki"
+
+	p := newReplicaPool(t, down)
+	defer p.Close()
+	for i := 0; i < 2; i++ {
+		if name := openRead(t, p); name != "primary" {
+			t.Errorf("expected primary when every replica is unhealthy, got %s", name)
+		}
+	}
+
+	p2 := newReplicaPool(t, down, withAppName(conn, "replica"))
+	defer p2.Close()
+	for i := 0; i < 2; i++ {
+		if name := openRead(t, p2); name != "replica" {
+			t.Errorf("expected the healthy replica, got %s", name)
+		}
+	}
+}
+
+// TestReleaseReplica tests that replica connections are returned to the
+// replica they came from.
+func TestReleaseReplica(t *testing.T) {
+	conn := dbtest.ConnString(t)
+	p := newReplicaPool(t, withAppName(conn, "replica"))
+	defer p.Close()
+
+	var conns []*pgx.Conn
+	for i := 0; i < 2; i++ {
+		c, err := p.OpenRead()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		conns = append(conns, c)
+	}
+	if n := p.Borrowed(); n != 2 {
+		t.Errorf("expected 2 borrowed replica connections, got %d", n)
+	}
+	for _, c := range conns {
+		p.Release(c)
+	}
+	if n := p.Borrowed(); n != 0 {
+		t.Fatalf("expected no borrowed replica connections, got %d", n)
+	}
+	// The replica allows only 2 connections, so this is only available if
+	// the earlier ones were returned to it.
+	if name := openRead(t, p); name != "replica" {
+		t.Errorf("expected the replica, got %s", name)
+	}
+}
@@ -0,0 +1,112 @@
+package db
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx"
+
+	"github.com/motki/core/log"
+)
+
+// A QueryTrace describes a single completed query.
+type QueryTrace struct {
+	SQL      string
+	Args     []interface{}
+	Duration time.Duration // Zero if the query failed.
+	Err      error
+
+	// Caller is the function that issued the query, such as
+	// "model.(*ProductManager).GetProduct". Empty if it could not be determined.
+	Caller string
+}
+
+// A QueryTracer is notified of each query executed through a ConnPool.
+//
+// Tracers are called synchronously by the goroutine that issued the query
+// and must not block.
+type QueryTracer interface {
+	TraceQuery(t QueryTrace)
+}
+
+// QueryTracerFunc is a function that implements QueryTracer.
+type QueryTracerFunc func(t QueryTrace)
+
+func (f QueryTracerFunc) TraceQuery(t QueryTrace) {
+	f(t)
+}
+
+// SlowQueryLogger returns a QueryTracer that logs queries that take longer
+// than the given threshold, along with any queries that fail.
+func SlowQueryLogger(l log.Logger, threshold time.Duration) QueryTracer {
+	return QueryTracerFunc(func(t QueryTrace) {
+		switch {
+		case t.Err != nil:
+			l.Warnf("db: query in %s failed: %s: %s", t.Caller, t.Err.Error(), t.SQL)
+		case t.Duration >= threshold:
+			l.Warnf("db: slow query in %s took %s: %s", t.Caller, t.Duration, t.SQL)
+		}
+	})
+}
+
+// tracer dispatches queries logged by pgx to any registered QueryTracers.
+type tracer struct {
+	mu      sync.RWMutex
+	tracers []QueryTracer
+}
+
+func (t *tracer) add(qt QueryTracer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tracers = append(t.tracers, qt)
+}
+
+// Log implements pgx.Logger.
+func (t *tracer) Log(level pgx.LogLevel, msg string, data map[string]interface{}) {
+	if msg != "Query" && msg != "Exec" {
+		return
+	}
+	t.mu.RLock()
+	tracers := t.tracers
+	t.mu.RUnlock()
+	if len(tracers) == 0 {
+		return
+	}
+	qt := QueryTrace{Caller: caller()}
+	qt.SQL, _ = data["sql"].(string)
+	qt.Args, _ = data["args"].([]interface{})
+	qt.Duration, _ = data["time"].(time.Duration)
+	qt.Err, _ = data["err"].(error)
+	for _, tr := range tracers {
+		tr.TraceQuery(qt)
+	}
+}
+
+// caller returns the name of the first function on the stack outside of this
+// package and pgx.
+func caller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		switch {
+		case strings.HasPrefix(f.Function, "github.com/jackc/pgx"),
+			strings.HasPrefix(f.Function, "github.com/motki/core/db."),
+			strings.HasPrefix(f.Function, "runtime."):
+		default:
+			return strings.TrimPrefix(f.Function, "github.com/motki/core/")
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// AddTracer registers a QueryTracer to be notified of every query executed
+// on connections from the pool.
+func (p *ConnPool) AddTracer(t QueryTracer) {
+	p.tracer.add(t)
+}
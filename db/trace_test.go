@@ -0,0 +1,36 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/motki/core/db"
+)
+
+// TestTraceCaller tests that the reported caller skips frames in this package
+// and pgx, and that only queries are traced.
+func TestTraceCaller(t *testing.T) {
+	var traces []db.QueryTrace
+	qt := db.QueryTracerFunc(func(t db.QueryTrace) {
+		traces = append(traces, t)
+	})
+	data := map[string]interface{}{"sql": "SELECT 1", "time": time.Millisecond}
+	db.TraceLog(qt, "Query", data)
+	func() {
+		db.TraceLog(qt, "Exec", data)
+	}()
+	db.TraceLog(qt, "Dialing PostgreSQL server", data)
+
+	expected := []string{"db_test.TestTraceCaller", "db_test.TestTraceCaller.func2"}
+	if len(traces) != len(expected) {
+		t.Fatalf("expected %d traces, got %d", len(expected), len(traces))
+	}
+	for i, e := range expected {
+		if traces[i].Caller != e {
+			t.Errorf("expected caller %s, got %s", e, traces[i].Caller)
+		}
+		if traces[i].SQL != "SELECT 1" || traces[i].Duration != time.Millisecond {
+			t.Errorf("expected query details, got %+v", traces[i])
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// DefaultTxRetries is the number of times WithTx retries a transaction that
+// fails due to a serialization failure or deadlock.
+const DefaultTxRetries = 3
+
+// TxOptions configures a transaction started with WithTxOptions.
+type TxOptions struct {
+	// Isolation level of the transaction. The server default is used if empty.
+	IsoLevel pgx.TxIsoLevel
+	// If true, the transaction is read only.
+	ReadOnly bool
+	// Number of times to retry the transaction after a serialization failure
+	// or deadlock. The transaction is attempted only once if zero.
+	MaxRetries int
+}
+
+// WithTx calls fn within a transaction, retrying up to DefaultTxRetries times
+// if the transaction fails due to a serialization failure or deadlock.
+//
+// See WithTxOptions for details.
+func (p *ConnPool) WithTx(ctx context.Context, fn func(tx *pgx.Tx) error) error {
+	return p.WithTxOptions(ctx, TxOptions{MaxRetries: DefaultTxRetries}, fn)
+}
+
+// WithTxOptions calls fn within a transaction using the given options.
+//
+// The transaction is committed if fn returns nil. If fn returns an error or
+// panics, the transaction is rolled back. A panic is re-raised after the
+// rollback.
+//
+// If fn or the commit fails due to a serialization failure or deadlock, the
+// entire transaction is retried, calling fn again, up to opts.MaxRetries
+// times. fn must therefore be safe to call more than once.
+func (p *ConnPool) WithTxOptions(ctx context.Context, opts TxOptions, fn func(tx *pgx.Tx) error) error {
+	var err error
+	for i := 0; ; i++ {
+		err = p.withTx(ctx, opts, fn)
+		if err == nil || i >= opts.MaxRetries || !isRetryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), err.Error())
+		case <-time.After(time.Duration(i+1) * 10 * time.Millisecond):
+		}
+	}
+}
+
+// withTx performs a single attempt of a transaction.
+func (p *ConnPool) withTx(ctx context.Context, opts TxOptions, fn func(tx *pgx.Tx) error) (err error) {
+	c, err := p.Open()
+	if err != nil {
+		return err
+	}
+	defer p.Release(c)
+	txOpts := &pgx.TxOptions{IsoLevel: opts.IsoLevel}
+	if opts.ReadOnly {
+		txOpts.AccessMode = pgx.ReadOnly
+	}
+	tx, err := c.BeginEx(ctx, txOpts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+		if err != nil && tx.Status() == pgx.TxStatusInProgress {
+			if errTx := tx.Rollback(); errTx != nil {
+				err = errors.Wrapf(err, "unable to rollback db transaction: %s", errTx.Error())
+			}
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.CommitEx(ctx)
+}
+
+// isRetryable returns true if the error indicates the transaction may succeed
+// if attempted again.
+func isRetryable(err error) bool {
+	pgErr, ok := errors.Cause(err).(pgx.PgError)
+	if !ok {
+		return false
+	}
+	switch pgErr.Code {
+	case "40001", // serialization_failure
+		"40P01": // deadlock_detected
+		return true
+	}
+	return false
+}
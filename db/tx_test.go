@@ -0,0 +1,34 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+
+	"github.com/motki/core/db"
+)
+
+// TestIsRetryable tests that only serialization failures and deadlocks are
+// retried, including when wrapped.
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"serialization failure", pgx.PgError{Code: "40001"}, true},
+		{"deadlock", pgx.PgError{Code: "40P01"}, true},
+		{"wrapped deadlock", errors.Wrap(pgx.PgError{Code: "40P01"}, "unable to commit"), true},
+		{"unique violation", pgx.PgError{Code: "23505"}, false},
+		{"other class 40", pgx.PgError{Code: "40002"}, false},
+		{"no rows", pgx.ErrNoRows, false},
+		{"plain error", errors.New("serialization failure"), false},
+		{"nil", nil, false},
+	}
+	for _, test := range tests {
+		if r := db.IsRetryable(test.err); r != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, r)
+		}
+	}
+}
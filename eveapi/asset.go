@@ -1,7 +1,7 @@
 package eveapi
 
 import (
-	"strconv"
+	"net/http"
 
 	"github.com/antihax/goesi/esi"
 	"github.com/antihax/goesi/optional"
@@ -24,19 +24,17 @@ func (api *EveAPI) GetCorporationAssets(ctx context.Context, corpID int) ([]*Ass
 		return nil, err
 	}
 	var assets []*Asset
-	for max, p := 1, 1; p <= max; p++ {
-		res, resp, err := api.client.ESI.AssetsApi.GetCorporationsCorporationIdAssets(
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.AssetsApi.GetCorporationsCorporationIdAssets(
 			ctx,
 			int32(corpID),
 			&esi.GetCorporationsCorporationIdAssetsOpts{Page: optional.NewInt32(int32(p))})
-		if err != nil {
-			return nil, err
-		}
-		max, err = strconv.Atoi(resp.Header.Get("X-Pages"))
-		if err != nil {
-			api.logger.Debugf("error reading X-Pages header: ", err.Error())
-		}
-		for _, a := range res {
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		for _, a := range page.([]esi.GetCorporationsCorporationIdAssets200Ok) {
 			assets = append(assets, &Asset{
 				ItemID:       int(a.ItemId),
 				LocationID:   int(a.LocationId),
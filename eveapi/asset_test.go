@@ -0,0 +1,57 @@
+package eveapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/antihax/goesi"
+	"github.com/antihax/goesi/esi"
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+	"github.com/motki/core/log"
+)
+
+// newTestAPI returns an EveAPI using the fake server and a context
+// authenticated as a character in the fake SSO.
+func newTestAPI(srv *esitest.Server) (*eveapi.EveAPI, context.Context) {
+	api := eveapi.New(eveapi.Config{ServerURL: srv.URL, RequestsPerSecond: -1}, log.New(log.Config{Level: "fatal"}))
+	tok := srv.Token(esitest.Login{CharacterID: 90000001, CharacterName: "Test Character"})
+	ctx := context.WithValue(context.Background(), goesi.ContextOAuth2, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: tok}))
+	return api, ctx
+}
+
+// TestGetCorporationAssets tests that every page of assets is fetched, and
+// that a failed page fails the whole fetch.
+func TestGetCorporationAssets(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.PageSize = 2
+	srv.Expires = -1
+	srv.SetCorporationAssets(98000001, []esi.GetCorporationsCorporationIdAssets200Ok{
+		{ItemId: 1, TypeId: 34, Quantity: 100},
+		{ItemId: 2, TypeId: 35, Quantity: 200},
+		{ItemId: 3, TypeId: 36, Quantity: 300},
+	})
+	api, ctx := newTestAPI(srv)
+
+	assets, err := api.GetCorporationAssets(ctx, 98000001)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(assets) != 3 {
+		t.Fatalf("expected 3 assets, got %d", len(assets))
+	}
+	for i, a := range assets {
+		if a.ItemID != i+1 {
+			t.Errorf("expected item %d at position %d, got %d", i+1, i, a.ItemID)
+		}
+	}
+
+	srv.Fail("/corporations/98000001/assets/?page=2", http.StatusForbidden)
+	if _, err := api.GetCorporationAssets(ctx, 98000001); err == nil {
+		t.Errorf("expected error when a page fails")
+	}
+}
@@ -1,7 +1,7 @@
 package eveapi
 
 import (
-	"strconv"
+	"net/http"
 
 	"github.com/antihax/goesi/esi"
 	"github.com/antihax/goesi/optional"
@@ -29,19 +29,17 @@ func (api *EveAPI) GetCorporationBlueprints(ctx context.Context, corpID int) ([]
 		return nil, err
 	}
 	var bps []*Blueprint
-	for p, max := 1, 1; p <= max; p++ {
-		res, resp, err := api.client.ESI.CorporationApi.GetCorporationsCorporationIdBlueprints(
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.CorporationApi.GetCorporationsCorporationIdBlueprints(
 			ctx,
 			int32(corpID),
 			&esi.GetCorporationsCorporationIdBlueprintsOpts{Page: optional.NewInt32(int32(p))})
-		if err != nil {
-			return nil, err
-		}
-		max, err = strconv.Atoi(resp.Header.Get("X-Pages"))
-		if err != nil {
-			api.logger.Debugf("error reading X-Pages header: ", err.Error())
-		}
-		for _, bp := range res {
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		for _, bp := range page.([]esi.GetCorporationsCorporationIdBlueprints200Ok) {
 			bps = append(bps, &Blueprint{
 				ItemID:             bp.ItemId,
 				LocationID:         bp.LocationId,
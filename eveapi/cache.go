@@ -0,0 +1,147 @@
+package eveapi
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gregjones/httpcache"
+	"github.com/jackc/pgx"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/log"
+)
+
+// DefaultCacheMaxAge is used when Config.CacheMaxAgeDays is not set.
+const DefaultCacheMaxAge = 7 * 24 * time.Hour
+
+// CacheStats contains counts of responses served with and without the cache.
+type CacheStats struct {
+	// Responses served from the cache, including those revalidated with a
+	// conditional request.
+	Hits int64
+	// Responses fetched from ESI.
+	Misses int64
+}
+
+// countingTransport counts the responses served from the cache by the
+// underlying httpcache.Transport.
+type countingTransport struct {
+	next http.RoundTripper
+
+	hits   int64
+	misses int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.Header.Get(httpcache.XFromCache) != "" {
+		atomic.AddInt64(&t.hits, 1)
+	} else {
+		atomic.AddInt64(&t.misses, 1)
+	}
+	return res, nil
+}
+
+func (t *countingTransport) stats() CacheStats {
+	return CacheStats{Hits: atomic.LoadInt64(&t.hits), Misses: atomic.LoadInt64(&t.misses)}
+}
+
+// PostgresCache is an httpcache.Cache that stores responses in the
+// app.http_cache table, allowing cached responses to survive a restart.
+//
+// Responses are kept after they expire so that they can be revalidated with
+// a conditional request. Entries that have not been updated within the
+// maximum age are removed by Prune.
+type PostgresCache struct {
+	pool   *db.ConnPool
+	logger log.Logger
+	maxAge time.Duration
+}
+
+// NewPostgresCache creates a new PostgresCache using the given connection pool.
+//
+// Entries older than maxAge are removed by Prune. If maxAge is zero,
+// DefaultCacheMaxAge is used.
+func NewPostgresCache(pool *db.ConnPool, maxAge time.Duration, l log.Logger) *PostgresCache {
+	if maxAge <= 0 {
+		maxAge = DefaultCacheMaxAge
+	}
+	return &PostgresCache{pool: pool, logger: l, maxAge: maxAge}
+}
+
+func (c *PostgresCache) Get(key string) ([]byte, bool) {
+	conn, err := c.pool.Open()
+	if err != nil {
+		c.logger.Warnf("eveapi: unable to read http cache: %s", err.Error())
+		return nil, false
+	}
+	defer c.pool.Release(conn)
+	var b []byte
+	err = conn.QueryRow(`SELECT response FROM app.http_cache WHERE key = $1`, key).Scan(&b)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			c.logger.Warnf("eveapi: unable to read http cache: %s", err.Error())
+		}
+		return nil, false
+	}
+	return b, true
+}
+
+func (c *PostgresCache) Set(key string, b []byte) {
+	conn, err := c.pool.Open()
+	if err != nil {
+		c.logger.Warnf("eveapi: unable to write http cache: %s", err.Error())
+		return
+	}
+	defer c.pool.Release(conn)
+	_, err = conn.Exec(
+		`INSERT INTO app.http_cache (key, response, updated_at)
+			VALUES($1, $2, NOW())
+			ON CONFLICT (key) DO UPDATE
+			  SET response = EXCLUDED.response
+			    , updated_at = EXCLUDED.updated_at`,
+		key,
+		b)
+	if err != nil {
+		c.logger.Warnf("eveapi: unable to write http cache: %s", err.Error())
+	}
+}
+
+func (c *PostgresCache) Delete(key string) {
+	conn, err := c.pool.Open()
+	if err != nil {
+		c.logger.Warnf("eveapi: unable to delete from http cache: %s", err.Error())
+		return
+	}
+	defer c.pool.Release(conn)
+	if _, err = conn.Exec(`DELETE FROM app.http_cache WHERE key = $1`, key); err != nil {
+		c.logger.Warnf("eveapi: unable to delete from http cache: %s", err.Error())
+	}
+}
+
+// Prune removes entries that have not been updated within the maximum age.
+//
+// Prune is intended to be invoked at regular intervals, such as with a
+// worker.Scheduler.
+func (c *PostgresCache) Prune() error {
+	conn, err := c.pool.Open()
+	if err != nil {
+		return err
+	}
+	defer c.pool.Release(conn)
+	tag, err := conn.Exec(
+		`DELETE FROM app.http_cache
+			WHERE updated_at < NOW() - ($1::DOUBLE PRECISION * INTERVAL '1 second')`,
+		c.maxAge.Seconds())
+	if err != nil {
+		return err
+	}
+	if n := tag.RowsAffected(); n > 0 {
+		c.logger.Debugf("eveapi: pruned %d entries from http cache", n)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package eveapi_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/antihax/goesi/esi"
+
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+	"github.com/motki/core/log"
+)
+
+// TestCacheStats tests that fresh and revalidated responses are counted as
+// cache hits, and responses fetched from ESI as misses.
+func TestCacheStats(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.SetStructure(1000000000001, esi.GetUniverseStructuresStructureIdOk{Name: "Test Structure"})
+	api, ctx := newTestAPI(srv)
+
+	expect := func(hits, misses int64) {
+		if s := api.CacheStats(); s.Hits != hits || s.Misses != misses {
+			t.Errorf("expected %d hits and %d misses, got %+v", hits, misses, s)
+		}
+	}
+	if _, err := api.GetStructure(ctx, 1000000000001); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expect(0, 1)
+	if _, err := api.GetStructure(ctx, 1000000000001); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expect(1, 1)
+	if n := srv.Requests("/universe/structures/1000000000001/"); n != 1 {
+		t.Errorf("expected a fresh response to be served without a request, got %d requests", n)
+	}
+
+	srv.Expires = -1
+	srv.SetStructure(1000000000002, esi.GetUniverseStructuresStructureIdOk{Name: "Expired Structure"})
+	for i := 0; i < 2; i++ {
+		if _, err := api.GetStructure(ctx, 1000000000002); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	expect(2, 2)
+
+	srv.Fail("/universe/structures/1000000000003/", http.StatusForbidden)
+	if _, err := api.GetStructure(ctx, 1000000000003); err == nil {
+		t.Errorf("expected error for failed request")
+	}
+	expect(2, 3)
+}
+
+// TestPostgresCache tests that responses are stored, replaced, deleted and
+// pruned.
+func TestPostgresCache(t *testing.T) {
+	p := dbtest.New(t)
+	defer p.Close()
+	dbtest.Truncate(t, p, "app.http_cache")
+	c := eveapi.NewPostgresCache(p, time.Hour, log.New(log.Config{Level: "fatal"}))
+
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected miss for missing key")
+	}
+	c.Set("a", []byte("first"))
+	c.Set("a", []byte("second"))
+	if b, ok := c.Get("a"); !ok || !bytes.Equal(b, []byte("second")) {
+		t.Errorf("expected second response, got %q", b)
+	}
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected miss for deleted key")
+	}
+
+	c.Set("old", []byte("old"))
+	c.Set("new", []byte("new"))
+	conn, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	_, err = conn.Exec(`UPDATE app.http_cache SET updated_at = NOW() - INTERVAL '2 hours' WHERE key = 'old'`)
+	p.Release(conn)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err = c.Prune(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, ok := c.Get("old"); ok {
+		t.Errorf("expected old entry to be pruned")
+	}
+	if _, ok := c.Get("new"); !ok {
+		t.Errorf("expected new entry to be kept")
+	}
+}
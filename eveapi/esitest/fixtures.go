@@ -0,0 +1,91 @@
+package esitest
+
+import (
+	"fmt"
+
+	"github.com/antihax/goesi/esi"
+)
+
+// SetCharacter registers the public information for a character.
+func (s *Server) SetCharacter(charID int32, v esi.GetCharactersCharacterIdOk) {
+	s.Set(fmt.Sprintf("/characters/%d/", charID), v)
+}
+
+// SetCorporation registers the public information for a corporation.
+func (s *Server) SetCorporation(corpID int32, v esi.GetCorporationsCorporationIdOk) {
+	s.Set(fmt.Sprintf("/corporations/%d/", corpID), v)
+}
+
+// SetCorporationDivisions registers the hangar and wallet divisions of a
+// corporation.
+func (s *Server) SetCorporationDivisions(corpID int32, v esi.GetCorporationsCorporationIdDivisionsOk) {
+	s.Set(fmt.Sprintf("/corporations/%d/divisions/", corpID), v)
+}
+
+// SetAlliance registers the public information for an alliance.
+func (s *Server) SetAlliance(allianceID int32, v esi.GetAlliancesAllianceIdOk) {
+	s.Set(fmt.Sprintf("/alliances/%d/", allianceID), v)
+}
+
+// SetCorporationAssets registers the assets of a corporation, served in pages.
+func (s *Server) SetCorporationAssets(corpID int32, v []esi.GetCorporationsCorporationIdAssets200Ok) {
+	s.SetPaged(fmt.Sprintf("/corporations/%d/assets/", corpID), v)
+}
+
+// SetCorporationBlueprints registers the blueprints of a corporation, served
+// in pages.
+func (s *Server) SetCorporationBlueprints(corpID int32, v []esi.GetCorporationsCorporationIdBlueprints200Ok) {
+	s.SetPaged(fmt.Sprintf("/corporations/%d/blueprints/", corpID), v)
+}
+
+// SetCorporationIndustryJobs registers the industry jobs of a corporation,
+// served in pages.
+func (s *Server) SetCorporationIndustryJobs(corpID int32, v []esi.GetCorporationsCorporationIdIndustryJobs200Ok) {
+	s.SetPaged(fmt.Sprintf("/corporations/%d/industry/jobs/", corpID), v)
+}
+
+// SetCorporationOrders registers the open market orders of a corporation,
+// served in pages.
+func (s *Server) SetCorporationOrders(corpID int32, v []esi.GetCorporationsCorporationIdOrders200Ok) {
+	s.SetPaged(fmt.Sprintf("/corporations/%d/orders/", corpID), v)
+}
+
+// SetCorporationOrdersHistory registers the closed market orders of a
+// corporation, served in pages.
+func (s *Server) SetCorporationOrdersHistory(corpID int32, v []esi.GetCorporationsCorporationIdOrdersHistory200Ok) {
+	s.SetPaged(fmt.Sprintf("/corporations/%d/orders/history/", corpID), v)
+}
+
+// SetCorporationStructures registers the structures owned by a corporation.
+func (s *Server) SetCorporationStructures(corpID int32, v []esi.GetCorporationsCorporationIdStructures200Ok) {
+	s.Set(fmt.Sprintf("/corporations/%d/structures/", corpID), v)
+}
+
+// SetStructure registers the public information for a structure.
+func (s *Server) SetStructure(structureID int64, v esi.GetUniverseStructuresStructureIdOk) {
+	s.Set(fmt.Sprintf("/universe/structures/%d/", structureID), v)
+}
+
+// SetMarketPrices registers the average and adjusted prices of all items.
+func (s *Server) SetMarketPrices(v []esi.GetMarketsPrices200Ok) {
+	s.Set("/markets/prices/", v)
+}
+
+// SetMarketOrdersRegion registers the orders in a region's market, served in
+// pages. The fixture is served regardless of the order type and type ID
+// requested; callers should register only the orders they expect to fetch.
+func (s *Server) SetMarketOrdersRegion(regionID int32, v []esi.GetMarketsRegionIdOrders200Ok) {
+	s.SetPaged(fmt.Sprintf("/markets/%d/orders/", regionID), v)
+}
+
+// SetMarketOrdersStructure registers the orders in a structure's market,
+// served in pages.
+func (s *Server) SetMarketOrdersStructure(structureID int64, v []esi.GetMarketsStructuresStructureId200Ok) {
+	s.SetPaged(fmt.Sprintf("/markets/structures/%d/", structureID), v)
+}
+
+// SetMarketHistory registers the daily history of a region's market. The
+// fixture is served regardless of the type ID requested.
+func (s *Server) SetMarketHistory(regionID int32, v []esi.GetMarketsRegionIdHistory200Ok) {
+	s.Set(fmt.Sprintf("/markets/%d/history/", regionID), v)
+}
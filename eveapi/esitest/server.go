@@ -0,0 +1,317 @@
+// Package esitest provides an in-process fake of the EVE Swagger API and EVE
+// SSO for testing.
+//
+// A Server serves fixtures registered with its Set methods. It supports
+// paging with the X-Pages header, conditional requests with ETags, the ESI
+// error limit headers, and injection of errors. Point an eveapi.EveAPI at the
+// server by setting ServerURL in its Config to the server's URL.
+//
+// Routes are matched without their version, so "/v3/corporations/1/assets/"
+// and "/latest/corporations/1/assets/" both match "/corporations/1/assets/".
+package esitest // import "github.com/motki/core/eveapi/esitest"
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the default number of items in each page of a
+	// paged endpoint.
+	DefaultPageSize = 1000
+
+	// DefaultExpires is the default time until a response expires.
+	DefaultExpires = 5 * time.Minute
+
+	// ErrorLimit is the number of errors allowed in each error window.
+	ErrorLimit = 100
+
+	// ErrorWindow is the length of each error window.
+	ErrorWindow = time.Minute
+)
+
+// statusErrorLimited is returned once the error limit has been exceeded.
+const statusErrorLimited = 420
+
+// A Server is a fake ESI and SSO server.
+type Server struct {
+	// URL of the server, such as "http://127.0.0.1:1234".
+	URL string
+
+	// Number of items in each page of a paged endpoint.
+	PageSize int
+	// Time until responses expire. Responses expire immediately if negative.
+	Expires time.Duration
+
+	// If set, OnRequest is called with the normalized path and page of each
+	// request before it is served. It may change the server's fixtures, for
+	// example to simulate data changing while pages are being fetched.
+	OnRequest func(path string, page int)
+
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string]*route
+	failures map[string][]int // Injected error statuses, by path.
+	requests map[string]int   // Number of requests, by path.
+
+	errorsRemain int
+	errorsReset  time.Time
+
+	sso ssoState
+}
+
+// route is a fixture served at a single path.
+type route struct {
+	body     interface{}
+	paged    bool
+	modified time.Time
+}
+
+// NewServer starts and returns a new Server.
+//
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		PageSize: DefaultPageSize,
+		Expires:  DefaultExpires,
+
+		routes:   make(map[string]*route),
+		failures: make(map[string][]int),
+		requests: make(map[string]int),
+
+		sso: newSSOState(),
+	}
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// versionPrefix matches the version segment at the start of a route.
+var versionPrefix = regexp.MustCompile(`^/(v\d+|latest|legacy|dev)/`)
+
+// normalize returns the path without its version and with a trailing slash.
+func normalize(path string) string {
+	path = versionPrefix.ReplaceAllString(path, "/")
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+	return path
+}
+
+// Set registers a fixture to be served as JSON at the given path.
+//
+// The path should not include a version, for example "/characters/90000001/".
+func (s *Server) Set(path string, v interface{}) {
+	s.set(path, v, false)
+}
+
+// SetPaged registers a slice to be served in pages at the given path.
+func (s *Server) SetPaged(path string, v interface{}) {
+	if reflect.ValueOf(v).Kind() != reflect.Slice {
+		panic("esitest: paged fixture must be a slice")
+	}
+	s.set(path, v, true)
+}
+
+func (s *Server) set(path string, v interface{}, paged bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[normalize(path)] = &route{body: v, paged: paged, modified: time.Now()}
+}
+
+// SetModified sets the time the fixture at the given path was last modified,
+// as reported in the Last-Modified header. Fixtures are otherwise modified
+// when they are registered.
+func (s *Server) SetModified(path string, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rt, ok := s.routes[normalize(path)]; ok {
+		rt.modified = t
+	}
+}
+
+// Fail causes the next request for the given path to fail with the given
+// status code. Calls are queued; each injected error is used once.
+//
+// To fail a single page of a paged endpoint, include the page in the path,
+// such as "/corporations/98000001/assets/?page=2".
+func (s *Server) Fail(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := normalize(path)
+	if i := strings.Index(path, "?"); i >= 0 {
+		key = normalize(path[:i]) + path[i:]
+	}
+	s.failures[key] = append(s.failures[key], status)
+}
+
+// Requests returns the number of requests received for the given path,
+// including conditional requests and failures.
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[normalize(path)]
+}
+
+// ErrorsRemaining returns the number of errors remaining in the current
+// error window.
+func (s *Server) ErrorsRemaining() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetErrors()
+	return s.errorsRemain
+}
+
+// resetErrors starts a new error window if the current one has passed.
+func (s *Server) resetErrors() {
+	if now := time.Now(); now.After(s.errorsReset) {
+		s.errorsRemain = ErrorLimit
+		s.errorsReset = now.Add(ErrorWindow)
+	}
+}
+
+// failure returns the injected error status for the request, if any.
+func (s *Server) failure(path string, page int) (int, bool) {
+	for _, key := range []string{path + "?page=" + strconv.Itoa(page), path} {
+		if q := s.failures[key]; len(q) > 0 {
+			s.failures[key] = q[1:]
+			return q[0], true
+		}
+	}
+	return 0, false
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := normalize(r.URL.Path)
+	if strings.HasPrefix(path, "/oauth/") {
+		s.serveSSO(w, r, path)
+		return
+	}
+	page := 1
+	if p := r.URL.Query().Get("page"); p != "" {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 {
+			s.writeError(w, http.StatusBadRequest, "invalid page")
+			return
+		}
+		page = n
+	}
+	if s.OnRequest != nil {
+		s.OnRequest(path, page)
+	}
+
+	s.mu.Lock()
+	s.requests[path]++
+	s.resetErrors()
+	if s.errorsRemain <= 0 {
+		s.mu.Unlock()
+		s.writeError(w, statusErrorLimited, "error limited")
+		return
+	}
+	if status, ok := s.failure(path, page); ok {
+		s.mu.Unlock()
+		s.writeError(w, status, http.StatusText(status))
+		return
+	}
+	rt, ok := s.routes[path]
+	if ok {
+		// Copy the route, which may be modified once the lock is released.
+		cp := *rt
+		rt = &cp
+	}
+	s.mu.Unlock()
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	body, pages := rt.body, 1
+	if rt.paged {
+		body, pages = s.page(rt.body, page)
+		if page > pages {
+			s.writeError(w, http.StatusNotFound, "requested page does not exist")
+			return
+		}
+		w.Header().Set("X-Pages", strconv.Itoa(pages))
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	sum := sha1.Sum(b)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	s.writeLimitHeaders(w)
+	w.Header().Set("Expires", time.Now().Add(s.Expires).UTC().Format(http.TimeFormat))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", rt.modified.UTC().Format(http.TimeFormat))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.Write(b)
+}
+
+// page returns the given page of a slice and the total number of pages.
+func (s *Server) page(v interface{}, page int) (interface{}, int) {
+	size := s.PageSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	rv := reflect.ValueOf(v)
+	n := rv.Len()
+	pages := (n + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+	start := (page - 1) * size
+	if start > n {
+		start = n
+	}
+	end := start + size
+	if end > n {
+		end = n
+	}
+	return rv.Slice(start, end).Interface(), pages
+}
+
+// writeLimitHeaders writes the error limit headers.
+func (s *Server) writeLimitHeaders(w http.ResponseWriter) {
+	s.mu.Lock()
+	remain, reset := s.errorsRemain, s.errorsReset
+	s.mu.Unlock()
+	h := w.Header()
+	h.Set("X-Esi-Error-Limit-Remain", strconv.Itoa(remain))
+	h.Set("X-Esi-Error-Limit-Reset", strconv.Itoa(int(time.Until(reset).Seconds()+0.5)))
+}
+
+// writeError writes an ESI error response, counting it against the error limit.
+func (s *Server) writeError(w http.ResponseWriter, status int, msg string) {
+	s.mu.Lock()
+	if s.errorsRemain > 0 {
+		s.errorsRemain--
+	}
+	s.mu.Unlock()
+	s.writeLimitHeaders(w)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{msg})
+}
@@ -0,0 +1,112 @@
+package esitest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/motki/core/eveapi/esitest"
+)
+
+type item struct {
+	ID int `json:"id"`
+}
+
+func get(t *testing.T, url string, etag string) *http.Response {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return res
+}
+
+// TestServerPaging tests that paged fixtures are split according to the
+// page size and that the X-Pages header is set.
+func TestServerPaging(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.PageSize = 2
+	srv.SetPaged("/corporations/1/assets/", []item{{1}, {2}, {3}})
+
+	res := get(t, srv.URL+"/v3/corporations/1/assets/?page=2", "")
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", res.StatusCode)
+	}
+	if p := res.Header.Get("X-Pages"); p != "2" {
+		t.Errorf("expected 2 pages, got %s", p)
+	}
+	var items []item
+	if err := json.NewDecoder(res.Body).Decode(&items); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(items) != 1 || items[0].ID != 3 {
+		t.Errorf("expected only item 3 on page 2, got %v", items)
+	}
+
+	res = get(t, srv.URL+"/latest/corporations/1/assets/?page=3", "")
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404 past the last page, got %d", res.StatusCode)
+	}
+	if n := srv.Requests("/corporations/1/assets/"); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+// TestServerETag tests that conditional requests are answered with 304 Not
+// Modified until the fixture changes.
+func TestServerETag(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.Set("/markets/prices/", []item{{1}})
+
+	res := get(t, srv.URL+"/v1/markets/prices/", "")
+	res.Body.Close()
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatalf("expected ETag header")
+	}
+	res = get(t, srv.URL+"/v1/markets/prices/", etag)
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotModified {
+		t.Errorf("expected status 304, got %d", res.StatusCode)
+	}
+
+	srv.Set("/markets/prices/", []item{{2}})
+	res = get(t, srv.URL+"/v1/markets/prices/", etag)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200 after the fixture changed, got %d", res.StatusCode)
+	}
+}
+
+// TestServerFail tests that injected errors are returned once each and
+// counted against the error limit.
+func TestServerFail(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.Set("/characters/1/", item{1})
+	srv.Fail("/characters/1/", http.StatusBadGateway)
+
+	res := get(t, srv.URL+"/v4/characters/1/", "")
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", res.StatusCode)
+	}
+	if r := res.Header.Get("X-Esi-Error-Limit-Remain"); r != "99" {
+		t.Errorf("expected 99 errors remaining, got %s", r)
+	}
+	res = get(t, srv.URL+"/v4/characters/1/", "")
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200 once the error was used, got %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,199 @@
+package esitest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TokenExpires is the time until an access token issued by the fake SSO
+// expires.
+const TokenExpires = 20 * time.Minute
+
+// A Login is an EVE character authenticated with the fake SSO.
+type Login struct {
+	CharacterID   int32
+	CharacterName string
+	Scopes        []string
+}
+
+// ssoState holds the codes and tokens issued by the fake SSO.
+type ssoState struct {
+	current *Login            // Character logged in at the authorize endpoint.
+	codes   map[string]*Login // Authorization codes, not yet exchanged.
+	access  map[string]*Login // Access tokens.
+	refresh map[string]*Login // Refresh tokens.
+	expires map[string]time.Time
+}
+
+func newSSOState() ssoState {
+	return ssoState{
+		codes:   make(map[string]*Login),
+		access:  make(map[string]*Login),
+		refresh: make(map[string]*Login),
+		expires: make(map[string]time.Time),
+	}
+}
+
+// randomToken returns a new random token.
+func randomToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic("esitest: unable to read random bytes: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
+// Login logs in the given character and returns an authorization code that
+// can be exchanged for a token.
+//
+// Subsequent visits to the SSO authorize endpoint are redirected back to the
+// application's return URL with a new code for this character.
+func (s *Server) Login(l Login) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sso.current = &l
+	return s.newCode(&l)
+}
+
+// newCode issues a new authorization code for the login.
+func (s *Server) newCode(l *Login) string {
+	code := randomToken()
+	s.sso.codes[code] = l
+	return code
+}
+
+// Token returns a new access token for the given character, bypassing the
+// authorization code flow.
+func (s *Server) Token(l Login) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	access, _ := s.newToken(&l)
+	return access
+}
+
+// newToken issues a new access and refresh token for the login.
+func (s *Server) newToken(l *Login) (access, refresh string) {
+	access, refresh = randomToken(), randomToken()
+	s.sso.access[access] = l
+	s.sso.refresh[refresh] = l
+	s.sso.expires[access] = time.Now().Add(TokenExpires)
+	return access, refresh
+}
+
+// serveSSO handles requests for the SSO endpoints.
+func (s *Server) serveSSO(w http.ResponseWriter, r *http.Request, path string) {
+	switch path {
+	case "/oauth/authorize/":
+		s.serveAuthorize(w, r)
+	case "/oauth/token/":
+		s.serveToken(w, r)
+	case "/oauth/verify/":
+		s.serveVerify(w, r)
+	default:
+		s.writeSSOError(w, http.StatusNotFound, "not_found")
+	}
+}
+
+// serveAuthorize redirects to the return URL with an authorization code for
+// the current login.
+func (s *Server) serveAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	u, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || !u.IsAbs() {
+		s.writeSSOError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	s.mu.Lock()
+	if s.sso.current == nil {
+		s.mu.Unlock()
+		s.writeSSOError(w, http.StatusUnauthorized, "access_denied")
+		return
+	}
+	code := s.newCode(s.sso.current)
+	s.mu.Unlock()
+	v := u.Query()
+	v.Set("code", code)
+	v.Set("state", q.Get("state"))
+	u.RawQuery = v.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// serveToken exchanges an authorization code or refresh token for a new
+// access token.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		s.writeSSOError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	s.mu.Lock()
+	var l *Login
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		code := r.PostForm.Get("code")
+		l = s.sso.codes[code]
+		delete(s.sso.codes, code)
+	case "refresh_token":
+		l = s.sso.refresh[r.PostForm.Get("refresh_token")]
+	default:
+		s.mu.Unlock()
+		s.writeSSOError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+	if l == nil {
+		s.mu.Unlock()
+		s.writeSSOError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	access, refresh := s.newToken(l)
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(struct {
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int    `json:"expires_in"`
+		RefreshToken string `json:"refresh_token"`
+	}{access, "Bearer", int(TokenExpires.Seconds()), refresh})
+}
+
+// serveVerify describes the character that owns the bearer token.
+func (s *Server) serveVerify(w http.ResponseWriter, r *http.Request) {
+	tok := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	l, ok := s.sso.access[tok]
+	exp := s.sso.expires[tok]
+	s.mu.Unlock()
+	if !ok || time.Now().After(exp) {
+		s.writeSSOError(w, http.StatusUnauthorized, "invalid_token")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(struct {
+		CharacterID        int32
+		CharacterName      string
+		ExpiresOn          string
+		Scopes             string
+		TokenType          string
+		CharacterOwnerHash string
+	}{
+		l.CharacterID,
+		l.CharacterName,
+		exp.UTC().Format("2006-01-02T15:04:05"),
+		strings.Join(l.Scopes, " "),
+		"Character",
+		hex.EncodeToString([]byte(l.CharacterName)),
+	})
+}
+
+// writeSSOError writes an OAuth2 error response.
+func (s *Server) writeSSOError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{code})
+}
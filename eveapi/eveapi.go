@@ -3,6 +3,8 @@ package eveapi // import "github.com/motki/core/eveapi"
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/motki/core/log"
 
@@ -21,37 +23,113 @@ type Config struct {
 	SecretKey string `toml:"secret_key"`
 	ReturnURL string `toml:"return_url"`
 	UserAgent string `toml:"user_agent"`
+
+	// Maximum number of requests sent to ESI per second. Defaults to
+	// DefaultRequestsPerSecond; a negative value disables the cap.
+	RequestsPerSecond int `toml:"requests_per_second"`
+	// Requests are paused until the ESI error window resets once this many
+	// errors remain. Defaults to DefaultErrorLimitThreshold.
+	ErrorLimitThreshold int `toml:"error_limit_threshold"`
+
+	// If enabled, ESI responses are cached in the database rather than in
+	// memory, preserving them across restarts.
+	PersistentCache bool `toml:"persistent_cache"`
+	// Cached responses not updated within this many days are removed.
+	// Defaults to 7 days.
+	CacheMaxAgeDays int `toml:"cache_max_age_days"`
+
+	// If set, requests for both ESI and SSO are sent to this URL instead,
+	// such as "http://127.0.0.1:8080". Intended for testing against a fake
+	// server; see the esitest package. An invalid URL is logged and ignored.
+	ServerURL string `toml:"server_url"`
 }
 
 // EveAPI is the entry point for interacting with the EVE Swagger API.
 type EveAPI struct {
 	client  *goesi.APIClient
 	ssoAuth *goesi.SSOAuthenticator
+	limiter *Limiter
+	cache   *countingTransport
+	server  *redirectTransport
 
 	logger log.Logger
 }
 
 // New creates a new EveAPI with the given configuration.
+//
+// Responses are cached in memory.
 func New(c Config, l log.Logger) *EveAPI {
+	return NewWithCache(c, l, httpcache.NewMemoryCache())
+}
+
+// NewWithCache creates a new EveAPI that caches responses in the given cache.
+//
+// Cached responses are used until they expire, after which they are
+// revalidated with a conditional request if possible.
+func NewWithCache(c Config, l log.Logger, cache httpcache.Cache) *EveAPI {
 	l.Debugf("eveapi: init with EVE Developer Portal application client ID: %s", c.ClientID)
 	l.Debugf("eveapi: SSO return URL: %s", c.ReturnURL)
 	l.Debugf("eveapi: API client user agent: %s", c.UserAgent)
-	t := httpcache.NewMemoryCacheTransport()
-	t.Transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
-	hc := &http.Client{Transport: t}
+	rate := c.RequestsPerSecond
+	if rate == 0 {
+		rate = DefaultRequestsPerSecond
+	}
+	threshold := c.ErrorLimitThreshold
+	if threshold == 0 {
+		threshold = DefaultErrorLimitThreshold
+	}
+	l.Debugf("eveapi: limiting to %d requests per second, pausing at %d errors remaining", rate, threshold)
+	var base http.RoundTripper = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	var rt *redirectTransport
+	if c.ServerURL != "" {
+		if u, err := url.Parse(c.ServerURL); err != nil || u.Host == "" {
+			l.Errorf("eveapi: invalid server URL %s, sending requests to ESI instead", strconv.Quote(c.ServerURL))
+		} else {
+			l.Warnf("eveapi: sending all ESI and SSO requests to %s", u.Host)
+			rt = &redirectTransport{next: base, target: u}
+			base = rt
+		}
+	}
+	lim := NewLimiter(base, rate, threshold, l)
+	t := httpcache.NewTransport(cache)
+	t.Transport = lim
+	ct := &countingTransport{next: t}
+	hc := &http.Client{Transport: ct}
 	return &EveAPI{
 		client:  goesi.NewAPIClient(hc, c.UserAgent),
 		ssoAuth: goesi.NewSSOAuthenticator(hc, c.ClientID, c.SecretKey, c.ReturnURL, AllScopes),
+		limiter: lim,
+		cache:   ct,
+		server:  rt,
 
 		logger: l,
 	}
 }
 
+// Limits returns the state of the ESI error limit and rate limiter.
+//
+// Callers performing many requests, such as background jobs, should back off
+// while the limiter is paused.
+func (api *EveAPI) Limits() LimitStatus {
+	return api.limiter.Status()
+}
+
+// CacheStats returns counts of responses served with and without the cache.
+func (api *EveAPI) CacheStats() CacheStats {
+	return api.cache.stats()
+}
+
 func (api *EveAPI) AuthorizeURL(state string, scopes ...string) string {
 	if len(scopes) == 0 {
 		scopes = AllScopes
 	}
-	return api.ssoAuth.AuthorizeURL(state, true, scopes)
+	u := api.ssoAuth.AuthorizeURL(state, true, scopes)
+	if api.server != nil {
+		if pu, err := url.Parse(u); err == nil {
+			u = api.server.redirect(pu).String()
+		}
+	}
+	return u
 }
 
 func (api *EveAPI) TokenExchange(code string) (*oauth2.Token, error) {
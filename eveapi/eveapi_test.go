@@ -0,0 +1,28 @@
+package eveapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+	"github.com/motki/core/log"
+)
+
+// TestNewServerURL tests that SSO URLs point at the configured server, and
+// that an invalid server URL is ignored.
+func TestNewServerURL(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	l := log.New(log.Config{Level: "fatal"})
+
+	api := eveapi.New(eveapi.Config{ServerURL: srv.URL}, l)
+	if u := api.AuthorizeURL("state"); !strings.HasPrefix(u, srv.URL) {
+		t.Errorf("expected authorize URL on %s, got %s", srv.URL, u)
+	}
+
+	api = eveapi.New(eveapi.Config{ServerURL: "not a url"}, l)
+	if u := api.AuthorizeURL("state"); strings.HasPrefix(u, "not a url") || !strings.Contains(u, "eveonline.com") {
+		t.Errorf("expected authorize URL on EVE SSO, got %s", u)
+	}
+}
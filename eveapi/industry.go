@@ -1,7 +1,7 @@
 package eveapi
 
 import (
-	"strconv"
+	"net/http"
 	"time"
 
 	"github.com/antihax/goesi/esi"
@@ -39,21 +39,19 @@ func (api *EveAPI) GetCorporationIndustryJobs(ctx context.Context, corpID int) (
 	if err != nil {
 		return nil, err
 	}
-	for max, p := 1, 1; p <= max; p++ {
-		res, resp, err := api.client.ESI.IndustryApi.GetCorporationsCorporationIdIndustryJobs(
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.IndustryApi.GetCorporationsCorporationIdIndustryJobs(
 			ctx,
 			int32(corpID),
 			&esi.GetCorporationsCorporationIdIndustryJobsOpts{
 				IncludeCompleted: optional.NewBool(true),
 				Page:             optional.NewInt32(int32(p))})
-		if err != nil {
-			return nil, err
-		}
-		max, err = strconv.Atoi(resp.Header.Get("X-Pages"))
-		if err != nil {
-			api.logger.Debugf("error reading X-Pages header: ", err.Error())
-		}
-		for _, j := range res {
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		for _, j := range page.([]esi.GetCorporationsCorporationIdIndustryJobs200Ok) {
 			job := &IndustryJob{
 				JobID:                int(j.JobId),
 				InstallerID:          int(j.InstallerId),
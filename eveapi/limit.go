@@ -0,0 +1,141 @@
+package eveapi
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/motki/core/log"
+)
+
+const (
+	// DefaultRequestsPerSecond is used when Config.RequestsPerSecond is not set.
+	DefaultRequestsPerSecond = 20
+	// DefaultErrorLimitThreshold is used when Config.ErrorLimitThreshold is not set.
+	DefaultErrorLimitThreshold = 10
+)
+
+// statusErrorLimited is returned by ESI once the error limit has been exceeded.
+const statusErrorLimited = 420
+
+// LimitStatus describes the state of a Limiter.
+type LimitStatus struct {
+	// Errors remaining in the current window, as last reported by ESI.
+	// -1 if ESI has not yet reported the error limit.
+	ErrorsRemaining int
+	// When the current error window resets.
+	ErrorsReset time.Time
+
+	// Whether requests are paused until ErrorsReset.
+	Paused bool
+}
+
+// A Limiter is an http.RoundTripper that protects against exceeding the ESI
+// error limit and caps the rate of outgoing requests.
+//
+// ESI reports the number of errors remaining in the current window with each
+// response. Once the number remaining reaches the threshold, all requests are
+// held until the window resets. Requests are also spaced so that no more than
+// the configured number are sent per second.
+type Limiter struct {
+	next      http.RoundTripper
+	logger    log.Logger
+	threshold int
+	interval  time.Duration // Minimum time between requests.
+
+	mu      sync.Mutex
+	nextReq time.Time // Earliest time the next request may be sent.
+	remain  int
+	reset   time.Time
+	paused  bool
+}
+
+// NewLimiter creates a new Limiter that sends requests using next.
+//
+// A rate of zero or less disables the requests-per-second cap. Requests are
+// paused when the remaining errors falls to threshold or below.
+func NewLimiter(next http.RoundTripper, rate int, threshold int, l log.Logger) *Limiter {
+	lim := &Limiter{next: next, logger: l, threshold: threshold, remain: -1}
+	if rate > 0 {
+		lim.interval = time.Second / time.Duration(rate)
+	}
+	return lim
+}
+
+// Status returns the current state of the limiter.
+func (lim *Limiter) Status() LimitStatus {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return LimitStatus{
+		ErrorsRemaining: lim.remain,
+		ErrorsReset:     lim.reset,
+		Paused:          lim.paused && time.Now().Before(lim.reset),
+	}
+}
+
+// delay reserves a slot for a request, returning how long to wait before
+// sending it.
+func (lim *Limiter) delay() time.Duration {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	now := time.Now()
+	at := now
+	if lim.paused {
+		if now.Before(lim.reset) {
+			at = lim.reset
+		} else {
+			lim.paused = false
+			lim.logger.Infof("eveapi: error limit window reset, resuming requests")
+		}
+	}
+	if lim.nextReq.After(at) {
+		at = lim.nextReq
+	}
+	lim.nextReq = at.Add(lim.interval)
+	return at.Sub(now)
+}
+
+// update records the error limit reported in the given response.
+func (lim *Limiter) update(res *http.Response) {
+	remain, err := strconv.Atoi(res.Header.Get("X-Esi-Error-Limit-Remain"))
+	if err != nil {
+		return
+	}
+	secs, err := strconv.Atoi(res.Header.Get("X-Esi-Error-Limit-Reset"))
+	if err != nil {
+		return
+	}
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.remain = remain
+	lim.reset = time.Now().Add(time.Duration(secs) * time.Second)
+	if lim.paused {
+		return
+	}
+	if res.StatusCode == statusErrorLimited {
+		lim.paused = true
+		lim.logger.Errorf("eveapi: error limit exceeded, pausing requests for %ds", secs)
+	} else if remain <= lim.threshold {
+		lim.paused = true
+		lim.logger.Warnf("eveapi: %d errors remaining, pausing requests for %ds", remain, secs)
+	}
+}
+
+func (lim *Limiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if d := lim.delay(); d > 0 {
+		t := time.NewTimer(d)
+		select {
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, req.Context().Err()
+		case <-t.C:
+		}
+	}
+	res, err := lim.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	lim.update(res)
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package eveapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/log"
+)
+
+// TestLimiterErrorLimit tests that requests are paused once the error limit
+// reaches the threshold, until the error window resets.
+func TestLimiterErrorLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Esi-Error-Limit-Remain", "5")
+		w.Header().Set("X-Esi-Error-Limit-Reset", "1")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	lim := eveapi.NewLimiter(http.DefaultTransport, -1, 10, log.New(log.Config{Level: "fatal"}))
+	hc := &http.Client{Transport: lim}
+
+	if s := lim.Status(); s.ErrorsRemaining != -1 || s.Paused {
+		t.Errorf("expected unknown error limit, got %+v", s)
+	}
+	res, err := hc.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	res.Body.Close()
+	s := lim.Status()
+	if s.ErrorsRemaining != 5 || !s.Paused {
+		t.Errorf("expected to be paused with 5 errors remaining, got %+v", s)
+	}
+
+	start := time.Now()
+	res, err = hc.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	res.Body.Close()
+	if time.Now().Before(s.ErrorsReset) {
+		t.Errorf("expected request to be held until %s, only waited %s", s.ErrorsReset, time.Now().Sub(start))
+	}
+}
+
+// TestLimiterRate tests that requests are spaced according to the rate limit.
+func TestLimiterRate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	lim := eveapi.NewLimiter(http.DefaultTransport, 20, 10, log.New(log.Config{Level: "fatal"}))
+	hc := &http.Client{Transport: lim}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		res, err := hc.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		res.Body.Close()
+	}
+	if d := time.Now().Sub(start); d < 200*time.Millisecond {
+		t.Errorf("expected 5 requests at 20/s to take at least 200ms, took %s", d)
+	}
+}
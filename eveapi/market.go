@@ -61,14 +61,28 @@ func (api *EveAPI) GetMarketPrices() (prices chan MarketPrice, cancelFn func(),
 	return prices, cancelFn, nil
 }
 
+// GetMarketOrdersRegionTypeID fetches the orders for the given type in the
+// given region and returns buy, sell and combined statistics, in that order.
 func (api *EveAPI) GetMarketOrdersRegionTypeID(regionID, typeID int) ([]*MarketStat, error) {
-	// TODO: implement
-	panic("not implemented")
-	return nil, nil
+	orders, err := api.GetMarketOrdersRegion(context.Background(), regionID, OrderTypeAll, typeID)
+	if err != nil {
+		return nil, err
+	}
+	return AggregateOrders(typeID, orders), nil
 }
 
+// StatKind describes the type of orders summarized in a MarketStat.
+type StatKind string
+
+const (
+	StatBuy  StatKind = "buy"
+	StatSell StatKind = "sell"
+	StatAll  StatKind = "all"
+)
+
 // MarketStat is reported price information for the given type.
 type MarketStat struct {
+	Kind        StatKind // Not set for market history.
 	TypeID      int
 	Volume      int
 	WAvg        decimal.Decimal
@@ -98,8 +112,10 @@ func (s marketStatSlice) Swap(i, j int) {
 	s[i], s[j] = s[j], s[i]
 }
 
-func (api *EveAPI) GetMarketHistoryRegionTypeID(regionID, typeID int) ([]*MarketStat, error) {
-	his, _, err := api.client.ESI.MarketApi.GetMarketsRegionIdHistory(context.Background(), int32(regionID), int32(typeID), nil)
+// GetMarketHistoryRegionTypeID fetches the daily market history for the given
+// type in the given region, ordered newest first.
+func (api *EveAPI) GetMarketHistoryRegionTypeID(ctx context.Context, regionID, typeID int) ([]*MarketStat, error) {
+	his, _, err := api.client.ESI.MarketApi.GetMarketsRegionIdHistory(ctx, int32(regionID), int32(typeID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +132,6 @@ func (api *EveAPI) GetMarketHistoryRegionTypeID(regionID, typeID int) ([]*Market
 		}
 		res = append(res, s)
 	}
-	sort.Reverse(marketStatSlice(res))
+	sort.Sort(sort.Reverse(marketStatSlice(res)))
 	return res, nil
 }
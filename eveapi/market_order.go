@@ -0,0 +1,212 @@
+package eveapi
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/antihax/goesi/esi"
+	"github.com/antihax/goesi/optional"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+)
+
+// Order types accepted by GetMarketOrdersRegion.
+const (
+	OrderTypeAll  = "all"
+	OrderTypeBuy  = "buy"
+	OrderTypeSell = "sell"
+)
+
+// PublicOrder is an order listed in a region or structure market.
+type PublicOrder struct {
+	OrderID      int
+	TypeID       int
+	LocationID   int
+	SystemID     int // Not set for orders in a structure market.
+	VolEntered   int
+	VolRemaining int
+	MinVolume    int
+	Range        string
+	Duration     int
+	Price        decimal.Decimal
+	Bid          bool
+	Issued       time.Time
+}
+
+// GetMarketOrdersRegion fetches every order in the given region's market.
+//
+// orderType is one of OrderTypeAll, OrderTypeBuy or OrderTypeSell. If typeID
+// is non-zero, only orders for that type are returned.
+func (api *EveAPI) GetMarketOrdersRegion(ctx context.Context, regionID int, orderType string, typeID int) ([]*PublicOrder, error) {
+	opts := &esi.GetMarketsRegionIdOrdersOpts{}
+	if typeID != 0 {
+		opts.TypeId = optional.NewInt32(int32(typeID))
+	}
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		o := *opts
+		o.Page = optional.NewInt32(int32(p))
+		return api.client.ESI.MarketApi.GetMarketsRegionIdOrders(ctx, orderType, int32(regionID), &o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var orders []*PublicOrder
+	for _, page := range pages {
+		for _, o := range page.([]esi.GetMarketsRegionIdOrders200Ok) {
+			orders = append(orders, &PublicOrder{
+				OrderID:      int(o.OrderId),
+				TypeID:       int(o.TypeId),
+				LocationID:   int(o.LocationId),
+				SystemID:     int(o.SystemId),
+				VolEntered:   int(o.VolumeTotal),
+				VolRemaining: int(o.VolumeRemain),
+				MinVolume:    int(o.MinVolume),
+				Range:        o.Range_,
+				Duration:     int(o.Duration),
+				Price:        decimal.NewFromFloat(o.Price),
+				Bid:          o.IsBuyOrder,
+				Issued:       o.Issued,
+			})
+		}
+	}
+	return orders, nil
+}
+
+// GetMarketOrdersStructure fetches every order in the given structure's market.
+//
+// The context must contain a token with the
+// ScopeESIMarketReadStructureMarkets scope for a character with access to
+// the structure's market.
+func (api *EveAPI) GetMarketOrdersStructure(ctx context.Context, structureID int) ([]*PublicOrder, error) {
+	_, err := TokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.MarketApi.GetMarketsStructuresStructureId(
+			ctx,
+			int64(structureID),
+			&esi.GetMarketsStructuresStructureIdOpts{Page: optional.NewInt32(int32(p))})
+	})
+	if err != nil {
+		return nil, err
+	}
+	var orders []*PublicOrder
+	for _, page := range pages {
+		for _, o := range page.([]esi.GetMarketsStructuresStructureId200Ok) {
+			orders = append(orders, &PublicOrder{
+				OrderID:      int(o.OrderId),
+				TypeID:       int(o.TypeId),
+				LocationID:   int(o.LocationId),
+				VolEntered:   int(o.VolumeTotal),
+				VolRemaining: int(o.VolumeRemain),
+				MinVolume:    int(o.MinVolume),
+				Range:        o.Range_,
+				Duration:     int(o.Duration),
+				Price:        decimal.NewFromFloat(o.Price),
+				Bid:          o.IsBuyOrder,
+				Issued:       o.Issued,
+			})
+		}
+	}
+	return orders, nil
+}
+
+// AggregateOrders summarizes the given orders for a single type into buy,
+// sell and combined statistics, in that order.
+//
+// Averages, variance and median are weighted by the remaining volume of each
+// order. FivePercent is the weighted average price of the best 5% of volume:
+// the highest priced buy orders, or the lowest priced sell orders.
+func AggregateOrders(typeID int, orders []*PublicOrder) []*MarketStat {
+	var buy, sell, all []*PublicOrder
+	for _, o := range orders {
+		if o.TypeID != typeID {
+			continue
+		}
+		if o.Bid {
+			buy = append(buy, o)
+		} else {
+			sell = append(sell, o)
+		}
+		all = append(all, o)
+	}
+	now := time.Now()
+	return []*MarketStat{
+		aggregate(typeID, StatBuy, buy, now),
+		aggregate(typeID, StatSell, sell, now),
+		aggregate(typeID, StatAll, all, now),
+	}
+}
+
+// aggregate computes a MarketStat from the given orders.
+func aggregate(typeID int, kind StatKind, orders []*PublicOrder, ts time.Time) *MarketStat {
+	stat := &MarketStat{TypeID: typeID, Kind: kind, Timestamp: ts}
+	if len(orders) == 0 {
+		return stat
+	}
+	type entry struct {
+		price float64
+		vol   float64
+	}
+	entries := make([]entry, len(orders))
+	var vol, sum, wsum float64
+	for i, o := range orders {
+		p, _ := o.Price.Float64()
+		v := float64(o.VolRemaining)
+		entries[i] = entry{p, v}
+		vol += v
+		sum += p
+		wsum += p * v
+	}
+	// Sort best price first: highest for buy orders, lowest otherwise.
+	sort.Slice(entries, func(i, j int) bool {
+		if kind == StatBuy {
+			return entries[i].price > entries[j].price
+		}
+		return entries[i].price < entries[j].price
+	})
+	stat.Volume = int(vol)
+	stat.Avg = decimal.NewFromFloat(sum / float64(len(entries)))
+	min, max := entries[0].price, entries[0].price
+	for _, e := range entries {
+		min = math.Min(min, e.price)
+		max = math.Max(max, e.price)
+	}
+	stat.Min = decimal.NewFromFloat(min)
+	stat.Max = decimal.NewFromFloat(max)
+	if vol == 0 {
+		return stat
+	}
+	wavg := wsum / vol
+	var variance float64
+	for _, e := range entries {
+		variance += e.vol * (e.price - wavg) * (e.price - wavg)
+	}
+	variance /= vol
+	stat.WAvg = decimal.NewFromFloat(wavg)
+	stat.Variance = decimal.NewFromFloat(variance)
+	stat.StdDev = decimal.NewFromFloat(math.Sqrt(variance))
+
+	var seen, fiveVol, fiveSum float64
+	var median float64
+	medianFound := false
+	limit := math.Max(vol*0.05, 1)
+	for _, e := range entries {
+		if fiveVol < limit {
+			v := math.Min(e.vol, limit-fiveVol)
+			fiveVol += v
+			fiveSum += v * e.price
+		}
+		seen += e.vol
+		if !medianFound && seen >= vol/2 {
+			median = e.price
+			medianFound = true
+		}
+	}
+	stat.Median = decimal.NewFromFloat(median)
+	stat.FivePercent = decimal.NewFromFloat(fiveSum / fiveVol)
+	return stat
+}
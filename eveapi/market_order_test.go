@@ -0,0 +1,138 @@
+package eveapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/antihax/goesi/esi"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+)
+
+// TestAggregateOrders tests that orders are summarized into buy, sell and
+// combined statistics weighted by volume.
+func TestAggregateOrders(t *testing.T) {
+	orders := []*eveapi.PublicOrder{
+		{TypeID: 34, Price: decimal.NewFromFloat(4), VolRemaining: 100, Bid: true},
+		{TypeID: 34, Price: decimal.NewFromFloat(5), VolRemaining: 300, Bid: true},
+		{TypeID: 34, Price: decimal.NewFromFloat(6), VolRemaining: 100},
+		{TypeID: 34, Price: decimal.NewFromFloat(8), VolRemaining: 100},
+		{TypeID: 35, Price: decimal.NewFromFloat(1000), VolRemaining: 1},
+	}
+	stats := eveapi.AggregateOrders(34, orders)
+	if len(stats) != 3 {
+		t.Fatalf("expected 3 stats, got %d", len(stats))
+	}
+	buy, sell, all := stats[0], stats[1], stats[2]
+	if buy.Kind != eveapi.StatBuy || sell.Kind != eveapi.StatSell || all.Kind != eveapi.StatAll {
+		t.Fatalf("unexpected kinds: %s, %s, %s", buy.Kind, sell.Kind, all.Kind)
+	}
+	expect := func(name string, actual decimal.Decimal, expected float64) {
+		if !actual.Equal(decimal.NewFromFloat(expected)) {
+			t.Errorf("expected %s to be %v, got %s", name, expected, actual)
+		}
+	}
+	if buy.Volume != 400 {
+		t.Errorf("expected buy volume 400, got %d", buy.Volume)
+	}
+	expect("buy wavg", buy.WAvg, 4.75)
+	expect("buy avg", buy.Avg, 4.5)
+	expect("buy median", buy.Median, 5)
+	expect("buy five percent", buy.FivePercent, 5)
+	expect("sell min", sell.Min, 6)
+	expect("sell max", sell.Max, 8)
+	expect("sell wavg", sell.WAvg, 7)
+	expect("sell variance", sell.Variance, 1)
+	expect("sell five percent", sell.FivePercent, 6)
+	if all.Volume != 600 {
+		t.Errorf("expected combined volume 600, got %d", all.Volume)
+	}
+}
+
+// TestGetMarketOrdersRegion tests that every page of a region's orders is
+// fetched, and that a failed page fails the whole fetch.
+func TestGetMarketOrdersRegion(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.PageSize = 2
+	srv.Expires = -1
+	srv.SetMarketOrdersRegion(10000002, []esi.GetMarketsRegionIdOrders200Ok{
+		{OrderId: 1, TypeId: 34, SystemId: 30000142, Price: 5, VolumeRemain: 100, IsBuyOrder: true},
+		{OrderId: 2, TypeId: 34, SystemId: 30000142, Price: 6, VolumeRemain: 200},
+		{OrderId: 3, TypeId: 34, SystemId: 30000144, Price: 7, VolumeRemain: 300},
+	})
+	api, _ := newTestAPI(srv)
+
+	orders, err := api.GetMarketOrdersRegion(context.Background(), 10000002, eveapi.OrderTypeAll, 34)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(orders) != 3 {
+		t.Fatalf("expected 3 orders, got %d", len(orders))
+	}
+	for i, o := range orders {
+		if o.OrderID != i+1 {
+			t.Errorf("expected order %d at position %d, got %d", i+1, i, o.OrderID)
+		}
+	}
+	if o := orders[0]; !o.Bid || o.SystemID != 30000142 || !o.Price.Equal(decimal.NewFromFloat(5)) {
+		t.Errorf("unexpected first order: %+v", o)
+	}
+	if n := srv.Requests("/markets/10000002/orders/"); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+
+	srv.Fail("/markets/10000002/orders/?page=2", http.StatusForbidden)
+	if _, err := api.GetMarketOrdersRegion(context.Background(), 10000002, eveapi.OrderTypeAll, 34); err == nil {
+		t.Errorf("expected error when a page fails")
+	}
+	if _, err := api.GetMarketOrdersRegion(context.Background(), 10000003, eveapi.OrderTypeAll, 0); err == nil {
+		t.Errorf("expected error for unknown region")
+	}
+}
+
+// TestGetMarketOrdersStructure tests that a structure's orders require a
+// token and that every page is fetched.
+func TestGetMarketOrdersStructure(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.PageSize = 2
+	srv.Expires = -1
+	srv.SetMarketOrdersStructure(1000000000001, []esi.GetMarketsStructuresStructureId200Ok{
+		{OrderId: 1, TypeId: 34, LocationId: 1000000000001, Price: 5, VolumeRemain: 100},
+		{OrderId: 2, TypeId: 35, LocationId: 1000000000001, Price: 10, VolumeRemain: 50},
+		{OrderId: 3, TypeId: 36, LocationId: 1000000000001, Price: 40, VolumeRemain: 10, IsBuyOrder: true},
+	})
+	api, ctx := newTestAPI(srv)
+
+	if _, err := api.GetMarketOrdersStructure(context.Background(), 1000000000001); err != eveapi.ErrNoToken {
+		t.Errorf("expected ErrNoToken without a token, got %v", err)
+	}
+	if n := srv.Requests("/markets/structures/1000000000001/"); n != 0 {
+		t.Errorf("expected no requests without a token, got %d", n)
+	}
+
+	orders, err := api.GetMarketOrdersStructure(ctx, 1000000000001)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(orders) != 3 {
+		t.Fatalf("expected 3 orders, got %d", len(orders))
+	}
+	for i, o := range orders {
+		if o.OrderID != i+1 || o.LocationID != 1000000000001 {
+			t.Errorf("unexpected order at position %d: %+v", i, o)
+		}
+	}
+	if !orders[2].Bid {
+		t.Errorf("expected order 3 to be a buy order")
+	}
+
+	srv.Fail("/markets/structures/1000000000001/", http.StatusForbidden)
+	if _, err := api.GetMarketOrdersStructure(ctx, 1000000000001); err == nil {
+		t.Errorf("expected error when access to the structure is denied")
+	}
+}
@@ -1,7 +1,7 @@
 package eveapi
 
 import (
-	"strconv"
+	"net/http"
 	"time"
 
 	"github.com/antihax/goesi/esi"
@@ -33,16 +33,14 @@ func (api *EveAPI) GetCorporationOrders(ctx context.Context, corpID int) (orders
 	if err != nil {
 		return nil, err
 	}
-	for max, p := 1, 1; p <= max; p++ {
-		res, resp, err := api.client.ESI.MarketApi.GetCorporationsCorporationIdOrders(ctx, int32(corpID), &esi.GetCorporationsCorporationIdOrdersOpts{Page: optional.NewInt32(int32(p))})
-		if err != nil {
-			return nil, err
-		}
-		max, err = strconv.Atoi(resp.Header.Get("X-Pages"))
-		if err != nil {
-			api.logger.Debugf("error reading X-Pages header: ", err.Error())
-		}
-		for _, j := range res {
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.MarketApi.GetCorporationsCorporationIdOrders(ctx, int32(corpID), &esi.GetCorporationsCorporationIdOrdersOpts{Page: optional.NewInt32(int32(p))})
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		for _, j := range page.([]esi.GetCorporationsCorporationIdOrders200Ok) {
 			order := &MarketOrder{
 				OrderID: int(j.OrderId),
 				//CharID:       int(j.CharId),
@@ -72,19 +70,17 @@ func (api *EveAPI) GetCorporationOrdersHistory(ctx context.Context, corpID int)
 	if err != nil {
 		return nil, err
 	}
-	for max, p := 1, 1; p <= max; p++ {
-		res, resp, err := api.client.ESI.MarketApi.GetCorporationsCorporationIdOrdersHistory(
+	pages, err := api.fetchPages(ctx, func(ctx context.Context, p int) (interface{}, *http.Response, error) {
+		return api.client.ESI.MarketApi.GetCorporationsCorporationIdOrdersHistory(
 			ctx,
 			int32(corpID),
 			&esi.GetCorporationsCorporationIdOrdersHistoryOpts{Page: optional.NewInt32(int32(p))})
-		if err != nil {
-			return nil, err
-		}
-		max, err = strconv.Atoi(resp.Header.Get("X-Pages"))
-		if err != nil {
-			api.logger.Debugf("error reading X-Pages header: ", err.Error())
-		}
-		for _, j := range res {
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		for _, j := range page.([]esi.GetCorporationsCorporationIdOrdersHistory200Ok) {
 			order := &MarketOrder{
 				OrderID: int(j.OrderId),
 				//CharID:       int(j.CharId),
@@ -0,0 +1,139 @@
+package eveapi
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// ErrPagesChanged is returned when the pages of a paged endpoint continue to
+// change while they are being fetched.
+var ErrPagesChanged = errors.New("eveapi: pages changed while fetching")
+
+const (
+	// maxPageWorkers is the maximum number of pages fetched concurrently.
+	maxPageWorkers = 4
+
+	// maxPageRestarts is the maximum number of times a paged fetch is
+	// restarted because the pages changed.
+	maxPageRestarts = 3
+)
+
+// A pageFunc fetches a single page of a paged endpoint, returning the page's
+// results along with the HTTP response.
+type pageFunc func(ctx context.Context, page int) (interface{}, *http.Response, error)
+
+// pageVersion identifies the version of the pages of an endpoint.
+type pageVersion struct {
+	pages        int
+	lastModified string
+}
+
+// versionOf returns the version of the pages that the response belongs to.
+func (api *EveAPI) versionOf(resp *http.Response) pageVersion {
+	v := pageVersion{pages: 1, lastModified: resp.Header.Get("Last-Modified")}
+	if h := resp.Header.Get("X-Pages"); h != "" {
+		n, err := strconv.Atoi(h)
+		if err != nil {
+			api.logger.Debugf("eveapi: error reading X-Pages header: %s", err.Error())
+		} else if n > 0 {
+			v.pages = n
+		}
+	}
+	return v
+}
+
+// fetchPages fetches every page of a paged endpoint, returning the results of
+// each page in order.
+//
+// The first page is fetched to find the number of pages from the X-Pages
+// header, then the remaining pages are fetched concurrently. If any page
+// fails, the entire fetch fails. If the pages change during the fetch, as
+// indicated by a different Last-Modified or X-Pages header, the fetch is
+// restarted from the first page.
+func (api *EveAPI) fetchPages(ctx context.Context, fetch pageFunc) ([]interface{}, error) {
+	for i := 0; i <= maxPageRestarts; i++ {
+		res, err := api.fetchAllPages(ctx, fetch)
+		if err != ErrPagesChanged {
+			return res, err
+		}
+		api.logger.Debugf("eveapi: pages changed while fetching, restarting")
+	}
+	return nil, ErrPagesChanged
+}
+
+// fetchAllPages performs a single attempt to fetch all pages.
+//
+// ErrPagesChanged is returned if any page belongs to a different version
+// than the first page.
+func (api *EveAPI) fetchAllPages(ctx context.Context, fetch pageFunc) ([]interface{}, error) {
+	first, resp, err := fetch(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	v := api.versionOf(resp)
+	res := make([]interface{}, v.pages)
+	res[0] = first
+	if v.pages == 1 {
+		return res, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	pages := make(chan int)
+	workers := maxPageWorkers
+	if v.pages-1 < workers {
+		workers = v.pages - 1
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range pages {
+				page, resp, err := fetch(ctx, p)
+				if err != nil {
+					fail(errors.Wrapf(err, "eveapi: unable to fetch page %d of %d", p, v.pages))
+					continue
+				}
+				if api.versionOf(resp) != v {
+					fail(ErrPagesChanged)
+					continue
+				}
+				res[p-1] = page
+			}
+		}()
+	}
+dispatch:
+	for p := 2; p <= v.pages; p++ {
+		select {
+		case pages <- p:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(pages)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,132 @@
+package eveapi_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/antihax/goesi/esi"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+)
+
+const testRegionOrders = "/markets/10000002/orders/"
+
+// newPagedServer returns a fake server with the given number of region
+// orders, served one per page.
+func newPagedServer(n int) *esitest.Server {
+	srv := esitest.NewServer()
+	srv.PageSize = 1
+	srv.Expires = -1
+	var orders []esi.GetMarketsRegionIdOrders200Ok
+	for i := 1; i <= n; i++ {
+		orders = append(orders, esi.GetMarketsRegionIdOrders200Ok{OrderId: int64(i), TypeId: 34})
+	}
+	srv.SetMarketOrdersRegion(10000002, orders)
+	return srv
+}
+
+func getRegionOrders(api *eveapi.EveAPI) ([]*eveapi.PublicOrder, error) {
+	return api.GetMarketOrdersRegion(context.Background(), 10000002, eveapi.OrderTypeAll, 34)
+}
+
+// TestFetchPagesConcurrent tests that pages after the first are fetched
+// concurrently and returned in order.
+func TestFetchPagesConcurrent(t *testing.T) {
+	srv := newPagedServer(8)
+	defer srv.Close()
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	srv.OnRequest = func(path string, page int) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}
+	api, _ := newTestAPI(srv)
+
+	orders, err := getRegionOrders(api)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(orders) != 8 {
+		t.Fatalf("expected 8 orders, got %d", len(orders))
+	}
+	for i, o := range orders {
+		if o.OrderID != i+1 {
+			t.Errorf("expected order %d at position %d, got %d", i+1, i, o.OrderID)
+		}
+	}
+	if n := srv.Requests(testRegionOrders); n != 8 {
+		t.Errorf("expected 8 requests, got %d", n)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("expected pages to be fetched concurrently, got at most %d at once", maxInFlight)
+	}
+}
+
+// TestFetchPagesFailure tests that a single failed page fails the fetch
+// without affecting later fetches.
+func TestFetchPagesFailure(t *testing.T) {
+	srv := newPagedServer(5)
+	defer srv.Close()
+	api, _ := newTestAPI(srv)
+
+	srv.Fail(testRegionOrders+"?page=3", http.StatusForbidden)
+	if _, err := getRegionOrders(api); err == nil {
+		t.Errorf("expected error when a page fails")
+	}
+	orders, err := getRegionOrders(api)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(orders) != 5 {
+		t.Errorf("expected 5 orders, got %d", len(orders))
+	}
+}
+
+// TestFetchPagesRestart tests that the fetch is restarted from the first page
+// when Last-Modified changes partway through, and that it gives up if the
+// pages keep changing.
+func TestFetchPagesRestart(t *testing.T) {
+	srv := newPagedServer(2)
+	defer srv.Close()
+	var mu sync.Mutex
+	changes := 1
+	srv.OnRequest = func(path string, page int) {
+		mu.Lock()
+		defer mu.Unlock()
+		if page == 2 && changes > 0 {
+			changes--
+			srv.SetModified(testRegionOrders, time.Now().Add(time.Duration(changes+1)*time.Hour))
+		}
+	}
+	api, _ := newTestAPI(srv)
+
+	orders, err := getRegionOrders(api)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(orders) != 2 {
+		t.Errorf("expected 2 orders, got %d", len(orders))
+	}
+	if n := srv.Requests(testRegionOrders); n != 4 {
+		t.Errorf("expected both pages to be fetched twice, got %d requests", n)
+	}
+
+	mu.Lock()
+	changes = 100
+	mu.Unlock()
+	if _, err := getRegionOrders(api); err != eveapi.ErrPagesChanged {
+		t.Errorf("expected ErrPagesChanged, got %v", err)
+	}
+}
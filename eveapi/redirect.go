@@ -0,0 +1,32 @@
+package eveapi
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// redirectTransport sends every request to another server, keeping the
+// original path and query.
+//
+// It is used to point the client at a server other than the live ESI and
+// SSO, such as a local fake for testing.
+type redirectTransport struct {
+	next   http.RoundTripper
+	target *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := new(http.Request)
+	*r = *req
+	u := *req.URL
+	r.URL = t.redirect(&u)
+	r.Host = r.URL.Host
+	return t.next.RoundTrip(r)
+}
+
+// redirect modifies u to point to the target server.
+func (t *redirectTransport) redirect(u *url.URL) *url.URL {
+	u.Scheme = t.target.Scheme
+	u.Host = t.target.Host
+	return u
+}
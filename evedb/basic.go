@@ -53,7 +53,7 @@ type Bloodline struct {
 }
 
 func (e *EveDB) GetRace(id int) (*Race, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (e *EveDB) GetRace(id int) (*Race, error) {
 
 // GetRaces fetches all Races from the database.
 func (e *EveDB) GetRaces() ([]*Race, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +112,7 @@ func (e *EveDB) GetRaces() ([]*Race, error) {
 }
 
 func (e *EveDB) GetAncestry(id int) (*Ancestry, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +136,7 @@ func (e *EveDB) GetAncestry(id int) (*Ancestry, error) {
 }
 
 func (e *EveDB) GetBloodline(id int) (*Bloodline, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,287 @@
+package evedb
+
+// Commonly used dogma attribute IDs.
+const (
+	AttributePowerOutput = 11
+	AttributeLowSlots    = 12
+	AttributeMedSlots    = 13
+	AttributeHiSlots     = 14
+	AttributePowergrid   = 30
+	AttributeCPUOutput   = 48
+	AttributeCPU         = 50
+	AttributeTechLevel   = 422
+	AttributeMetaLevel   = 633
+	AttributeCalibration = 1132
+	AttributeRigSlots    = 1137
+	AttributeRigCost     = 1153
+
+	AttributeRequiredSkill1      = 182
+	AttributeRequiredSkill2      = 183
+	AttributeRequiredSkill3      = 184
+	AttributeRequiredSkill1Level = 277
+	AttributeRequiredSkill2Level = 278
+	AttributeRequiredSkill3Level = 279
+	AttributeRequiredSkill4      = 1285
+	AttributeRequiredSkill4Level = 1286
+	AttributeRequiredSkill5Level = 1287
+	AttributeRequiredSkill6Level = 1288
+	AttributeRequiredSkill5      = 1289
+	AttributeRequiredSkill6      = 1290
+)
+
+// Commonly used dogma effect IDs.
+const (
+	EffectLowPower  = 11
+	EffectHiPower   = 12
+	EffectMedPower  = 13
+	EffectRigSlot   = 2663
+	EffectSubSystem = 3772
+)
+
+// requiredSkillAttributes maps each required skill attribute to the attribute
+// containing the required level.
+var requiredSkillAttributes = [][2]int{
+	{AttributeRequiredSkill1, AttributeRequiredSkill1Level},
+	{AttributeRequiredSkill2, AttributeRequiredSkill2Level},
+	{AttributeRequiredSkill3, AttributeRequiredSkill3Level},
+	{AttributeRequiredSkill4, AttributeRequiredSkill4Level},
+	{AttributeRequiredSkill5, AttributeRequiredSkill5Level},
+	{AttributeRequiredSkill6, AttributeRequiredSkill6Level},
+}
+
+// An AttributeType describes a dogma attribute.
+type AttributeType struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	UnitID      int    `json:"unit_id"`
+
+	DefaultValue float64 `json:"default_value"`
+	HighIsGood   bool    `json:"high_is_good"`
+	Stackable    bool    `json:"stackable"`
+	Published    bool    `json:"published"`
+}
+
+// An Attribute is the value of a dogma attribute for a specific type.
+type Attribute struct {
+	*AttributeType
+	Value float64 `json:"value"`
+}
+
+// An Effect is a dogma effect of a specific type.
+type Effect struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Category    int    `json:"category"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+// A SkillRequirement is a skill and level required to use a type.
+type SkillRequirement struct {
+	SkillID int `json:"skill_id"`
+	Level   int `json:"level"`
+}
+
+// TypeDogma contains the dogma attributes and effects of a type.
+type TypeDogma struct {
+	TypeID     int          `json:"type_id"`
+	Attributes []*Attribute `json:"attributes"`
+	Effects    []*Effect    `json:"effects"`
+}
+
+// Attribute returns the given attribute, or nil if the type does not have it.
+func (d *TypeDogma) Attribute(attributeID int) *Attribute {
+	for _, a := range d.Attributes {
+		if a.ID == attributeID {
+			return a
+		}
+	}
+	return nil
+}
+
+// Value returns the value of the given attribute, or zero if the type does
+// not have it.
+func (d *TypeDogma) Value(attributeID int) float64 {
+	if a := d.Attribute(attributeID); a != nil {
+		return a.Value
+	}
+	return 0
+}
+
+// HasEffect returns true if the type has the given effect.
+func (d *TypeDogma) HasEffect(effectID int) bool {
+	for _, e := range d.Effects {
+		if e.ID == effectID {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredSkills returns the skills and levels required to use the type.
+func (d *TypeDogma) RequiredSkills() []SkillRequirement {
+	var res []SkillRequirement
+	for _, attrs := range requiredSkillAttributes {
+		id := int(d.Value(attrs[0]))
+		if id == 0 {
+			continue
+		}
+		res = append(res, SkillRequirement{SkillID: id, Level: int(d.Value(attrs[1]))})
+	}
+	return res
+}
+
+const baseQueryAttributeType = `SELECT
+  attr."attributeID"
+, COALESCE(attr."attributeName", '')
+, COALESCE(attr."displayName", '')
+, COALESCE(attr."description", '')
+, COALESCE(attr."unitID", 0)
+, COALESCE(attr."defaultValue", 0)
+, COALESCE(attr."highIsGood", FALSE)
+, COALESCE(attr."stackable", FALSE)
+, COALESCE(attr."published", FALSE)
+`
+
+// GetAttributeTypes fetches the given dogma attributes.
+//
+// Attributes that do not exist are omitted from the result.
+func (e *EveDB) GetAttributeTypes(attributeIDs ...int) ([]*AttributeType, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	rs, err := c.Query(
+		baseQueryAttributeType+`
+			FROM evesde."dgmAttributeTypes" attr
+			WHERE attr."attributeID" = ANY($1)
+			ORDER BY attr."attributeID"`, int32s(attributeIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var res []*AttributeType
+	for rs.Next() {
+		r := &AttributeType{}
+		if err := rs.Scan(&r.ID, &r.Name, &r.DisplayName, &r.Description, &r.UnitID, &r.DefaultValue, &r.HighIsGood, &r.Stackable, &r.Published); err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTypeDogma fetches the dogma attributes and effects of the given type.
+func (e *EveDB) GetTypeDogma(typeID int) (*TypeDogma, error) {
+	res, err := e.GetTypeDogmas(typeID)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+// GetTypeDogmas fetches the dogma attributes and effects of each of the given
+// types using a single query for all attributes and another for all effects.
+//
+// The result contains one TypeDogma for each type, in the order given. A type
+// without attributes or effects has an empty TypeDogma.
+func (e *EveDB) GetTypeDogmas(typeIDs ...int) ([]*TypeDogma, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	var res []*TypeDogma
+	byID := make(map[int]*TypeDogma)
+	for _, id := range typeIDs {
+		d, ok := byID[id]
+		if !ok {
+			d = &TypeDogma{TypeID: id}
+			byID[id] = d
+		}
+		res = append(res, d)
+	}
+	if len(res) == 0 {
+		return res, nil
+	}
+	ids := int32s(typeIDs)
+	// Types with the same attribute share its AttributeType.
+	attrs := make(map[int]*AttributeType)
+	rs, err := c.Query(
+		baseQueryAttributeType+`
+			, ta."typeID"
+			, COALESCE(ta."valueFloat", ta."valueInt", attr."defaultValue", 0)
+			FROM evesde."dgmTypeAttributes" ta
+			INNER JOIN evesde."dgmAttributeTypes" attr ON attr."attributeID" = ta."attributeID"
+			WHERE ta."typeID" = ANY($1)
+			ORDER BY ta."typeID", ta."attributeID"`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var typeID int
+		var val float64
+		r := &AttributeType{}
+		if err := rs.Scan(&r.ID, &r.Name, &r.DisplayName, &r.Description, &r.UnitID, &r.DefaultValue, &r.HighIsGood, &r.Stackable, &r.Published, &typeID, &val); err != nil {
+			return nil, err
+		}
+		if a, ok := attrs[r.ID]; ok {
+			r = a
+		} else {
+			attrs[r.ID] = r
+		}
+		d := byID[typeID]
+		d.Attributes = append(d.Attributes, &Attribute{AttributeType: r, Value: val})
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+
+	rs, err = c.Query(
+		`SELECT
+			  te."typeID"
+			, eff."effectID"
+			, COALESCE(eff."effectName", '')
+			, COALESCE(eff."displayName", '')
+			, COALESCE(eff."description", '')
+			, COALESCE(eff."effectCategory", 0)
+			, COALESCE(te."isDefault", FALSE)
+			FROM evesde."dgmTypeEffects" te
+			INNER JOIN evesde."dgmEffects" eff ON eff."effectID" = te."effectID"
+			WHERE te."typeID" = ANY($1)
+			ORDER BY te."typeID", te."effectID"`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var typeID int
+		r := &Effect{}
+		if err := rs.Scan(&typeID, &r.ID, &r.Name, &r.DisplayName, &r.Description, &r.Category, &r.IsDefault); err != nil {
+			return nil, err
+		}
+		d := byID[typeID]
+		d.Effects = append(d.Effects, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// int32s converts the given IDs for use as a Postgres INTEGER[] parameter.
+func int32s(ids []int) []int32 {
+	res := make([]int32, len(ids))
+	for i, id := range ids {
+		res[i] = int32(id)
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package evedb_test
+
+import (
+	"testing"
+
+	"github.com/motki/core/evedb"
+)
+
+func newTestDogma() *evedb.TypeDogma {
+	attr := func(id int, val float64) *evedb.Attribute {
+		return &evedb.Attribute{AttributeType: &evedb.AttributeType{ID: id}, Value: val}
+	}
+	return &evedb.TypeDogma{
+		TypeID: 2281,
+		Attributes: []*evedb.Attribute{
+			attr(evedb.AttributePowergrid, 1),
+			attr(evedb.AttributeCPU, 44),
+			attr(evedb.AttributeRequiredSkill1, 3416),
+			attr(evedb.AttributeRequiredSkill1Level, 1),
+			attr(evedb.AttributeRequiredSkill2, 3300),
+			attr(evedb.AttributeRequiredSkill2Level, 3),
+		},
+		Effects: []*evedb.Effect{
+			{ID: evedb.EffectMedPower},
+		},
+	}
+}
+
+func TestTypeDogmaValue(t *testing.T) {
+	d := newTestDogma()
+	if v := d.Value(evedb.AttributeCPU); v != 44 {
+		t.Errorf("expected cpu of 44, got %v", v)
+	}
+	if v := d.Value(evedb.AttributeMetaLevel); v != 0 {
+		t.Errorf("expected missing attribute to be 0, got %v", v)
+	}
+	if d.Attribute(evedb.AttributeMetaLevel) != nil {
+		t.Errorf("expected missing attribute to be nil")
+	}
+	if !d.HasEffect(evedb.EffectMedPower) || d.HasEffect(evedb.EffectHiPower) {
+		t.Errorf("expected only the med slot effect, got %v", d.Effects)
+	}
+}
+
+func TestTypeDogmaRequiredSkills(t *testing.T) {
+	skills := newTestDogma().RequiredSkills()
+	expected := []evedb.SkillRequirement{{SkillID: 3416, Level: 1}, {SkillID: 3300, Level: 3}}
+	if len(skills) != len(expected) {
+		t.Fatalf("expected %d required skills, got %v", len(expected), skills)
+	}
+	for i, e := range expected {
+		if skills[i] != e {
+			t.Errorf("expected required skill %v, got %v", e, skills[i])
+		}
+	}
+}
@@ -5,15 +5,68 @@
 package evedb // import "github.com/motki/core/evedb"
 
 import (
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx"
+
 	"github.com/motki/core/db"
 )
 
 // EveDB is the central service for accessing all EVE Static Dump data.
 type EveDB struct {
 	pool *db.ConnPool
+
+	graphMu        sync.Mutex
+	graph          *JumpGraph
+	graphVersion   string    // Version of the static dump the graph was loaded from.
+	graphCheckedAt time.Time // When graphVersion was last compared to Version.
 }
 
 // New creates a new EveDB using the given connection pool.
 func New(p *db.ConnPool) *EveDB {
 	return &EveDB{pool: p}
 }
+
+// Version returns an identifier for the currently installed static dump.
+//
+// If the dump was loaded with an Importer, the identifier is the version
+// recorded by the most recent import. Otherwise, it is a fingerprint of the
+// dump's contents; it changes whenever a new dump with different items,
+// materials, or solar systems is installed.
+func (e *EveDB) Version() (string, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return "", err
+	}
+	defer e.pool.Release(c)
+	var imported bool
+	err = c.QueryRow(`SELECT TO_REGCLASS('evesde."sdeVersion"') IS NOT NULL`).Scan(&imported)
+	if err != nil {
+		return "", err
+	}
+	var v string
+	if imported {
+		err = c.QueryRow(
+			`SELECT v."version"
+				FROM evesde."sdeVersion" v
+				ORDER BY v."importedAt" DESC
+				LIMIT 1`).Scan(&v)
+		if err != nil && err != pgx.ErrNoRows {
+			return "", err
+		}
+	}
+	if v != "" {
+		return v, nil
+	}
+	err = c.QueryRow(
+		`SELECT MD5(CONCAT_WS(':'
+			, (SELECT COUNT(*) FROM evesde."invTypes")
+			, (SELECT MAX("typeID") FROM evesde."invTypes")
+			, (SELECT COUNT(*) FROM evesde."invTypeMaterials")
+			, (SELECT COUNT(*) FROM evesde."mapSolarSystems")))`).Scan(&v)
+	if err != nil {
+		return "", err
+	}
+	return v, nil
+}
@@ -0,0 +1,212 @@
+package evedb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/log"
+)
+
+// An Importer loads CCP's static data export into the evesde schema.
+type Importer struct {
+	pool   *db.ConnPool
+	logger log.Logger
+}
+
+// NewImporter creates a new Importer using the given connection pool.
+func NewImporter(p *db.ConnPool, l log.Logger) *Importer {
+	return &Importer{pool: p, logger: l}
+}
+
+// ImportFile loads the static data export in the zip archive at the given
+// path, returning the version of the export.
+//
+// See LoadSDE and Import for details.
+func (i *Importer) ImportFile(ctx context.Context, filename string) (string, error) {
+	s, err := LoadSDE(filename)
+	if err != nil {
+		return "", err
+	}
+	if err := i.Import(ctx, s); err != nil {
+		return "", err
+	}
+	return s.Version, nil
+}
+
+// Names of the schemas used while importing.
+const (
+	importSchema   = "evesde_import"
+	previousSchema = "evesde_old"
+)
+
+// importLockID is the advisory lock held while importing, so that concurrent
+// imports do not overwrite each other's staging schema.
+const importLockID = 7461826
+
+// Import replaces the tables in the evesde schema with the contents of the
+// given export and records its version.
+//
+// The export is loaded into a staging schema, which then replaces evesde by
+// renaming the schemas. Queries against evesde are not blocked while the
+// export is loaded; they see either the previous export or the new one in
+// full. Tables in evesde that are not part of the export, such as the version
+// history, are moved into the new schema. Other objects in evesde, as well
+// as any privileges granted on it, are not carried over.
+func (i *Importer) Import(ctx context.Context, s *SDE) error {
+	err := i.pool.WithTx(ctx, func(tx *pgx.Tx) error {
+		if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1)`, importLockID); err != nil {
+			return err
+		}
+		// Discard the remains of an interrupted import.
+		if _, err := tx.Exec(`DROP SCHEMA IF EXISTS ` + importSchema + ` CASCADE`); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`CREATE SCHEMA ` + importSchema); err != nil {
+			return err
+		}
+		for _, d := range sdeTables {
+			t := s.Table(d.name)
+			if t == nil {
+				return errors.Errorf("evedb: sde is missing table %s", d.name)
+			}
+			if err := createTable(tx, importSchema, d); err != nil {
+				return errors.Wrapf(err, "evedb: unable to create table %s", d.name)
+			}
+			n, err := tx.CopyFrom(pgx.Identifier{importSchema, d.name}, t.Columns, pgx.CopyFromRows(t.Rows))
+			if err != nil {
+				return errors.Wrapf(err, "evedb: unable to import table %s", d.name)
+			}
+			if err := createIndexes(tx, importSchema, d); err != nil {
+				return errors.Wrapf(err, "evedb: unable to index table %s", d.name)
+			}
+			i.logger.Debugf("evedb: imported %d rows into %s", n, d.name)
+		}
+		return swapSchemas(tx, s)
+	})
+	if err != nil {
+		return err
+	}
+	i.logger.Infof("evedb: imported sde version %s", s.Version)
+	// The previous tables are dropped separately so that queries started
+	// before the swap are not interrupted; dropping them waits until those
+	// queries finish.
+	return i.pool.WithTx(ctx, func(tx *pgx.Tx) error {
+		_, err := tx.Exec(`DROP SCHEMA IF EXISTS ` + previousSchema + ` CASCADE`)
+		return err
+	})
+}
+
+// swapSchemas replaces the evesde schema with the staging schema, recording
+// the version of the export.
+func swapSchemas(tx *pgx.Tx, s *SDE) error {
+	var exists bool
+	if err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM pg_namespace WHERE nspname = 'evesde')`).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		names, err := extraTables(tx)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			_, err := tx.Exec(`ALTER TABLE ` + pgx.Identifier{"evesde", name}.Sanitize() + ` SET SCHEMA ` + importSchema)
+			if err != nil {
+				return errors.Wrapf(err, "evedb: unable to keep table %s", name)
+			}
+		}
+	}
+	_, err := tx.Exec(
+		`CREATE TABLE IF NOT EXISTS ` + importSchema + `."sdeVersion" (
+			"version" VARCHAR(64) NOT NULL,
+			"checksum" CHAR(64) NOT NULL,
+			"importedAt" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		)`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		`INSERT INTO `+importSchema+`."sdeVersion" ("version", "checksum") VALUES($1, $2)`,
+		s.Version, s.Checksum)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if _, err = tx.Exec(`DROP SCHEMA IF EXISTS ` + previousSchema + ` CASCADE`); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(`ALTER SCHEMA evesde RENAME TO ` + previousSchema); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(`ALTER SCHEMA ` + importSchema + ` RENAME TO evesde`)
+	return err
+}
+
+// extraTables returns the tables in the evesde schema that are not in the
+// staging schema.
+func extraTables(tx *pgx.Tx) ([]string, error) {
+	rs, err := tx.Query(
+		`SELECT t.tablename
+			FROM pg_tables t
+			WHERE t.schemaname = 'evesde'
+			  AND NOT EXISTS(
+			    SELECT 1 FROM pg_tables n
+			      WHERE n.schemaname = $1 AND n.tablename = t.tablename)`, importSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var res []string
+	for rs.Next() {
+		var name string
+		if err := rs.Scan(&name); err != nil {
+			return nil, err
+		}
+		res = append(res, name)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// quoteIdents quotes each identifier and joins them with commas.
+func quoteIdents(idents []string) string {
+	var res []string
+	for _, id := range idents {
+		res = append(res, pgx.Identifier{id}.Sanitize())
+	}
+	return strings.Join(res, ", ")
+}
+
+// createTable creates the table, empty, in the given schema.
+func createTable(tx *pgx.Tx, schema string, d sdeTableDef) error {
+	name := pgx.Identifier{schema, d.name}.Sanitize()
+	var cols []string
+	for _, c := range d.columns {
+		cols = append(cols, pgx.Identifier{c.name}.Sanitize()+" "+c.typ)
+	}
+	if len(d.key) > 0 {
+		cols = append(cols, "PRIMARY KEY ("+quoteIdents(d.key)+")")
+	}
+	_, err := tx.Exec(`CREATE TABLE ` + name + ` (` + strings.Join(cols, ", ") + `)`)
+	return err
+}
+
+// createIndexes creates an index on each of the table's indexed columns.
+func createIndexes(tx *pgx.Tx, schema string, d sdeTableDef) error {
+	name := pgx.Identifier{schema, d.name}.Sanitize()
+	for _, col := range d.indexes {
+		idx := pgx.Identifier{fmt.Sprintf("%s_%s_idx", d.name, col)}.Sanitize()
+		_, err := tx.Exec(`CREATE INDEX ` + idx + ` ON ` + name + ` (` + quoteIdents([]string{col}) + `)`)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
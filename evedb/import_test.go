@@ -0,0 +1,87 @@
+package evedb_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/evedb"
+	"github.com/motki/core/log"
+)
+
+func importSDE(t *testing.T, i *evedb.Importer, files map[string]string) *evedb.SDE {
+	r := newSDEZip(t, files)
+	s, err := evedb.ReadSDE(r, r.Size())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err = i.Import(context.Background(), s); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return s
+}
+
+func queryInt(t *testing.T, p *db.ConnPool, sql string) int {
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	var n int
+	if err = c.QueryRow(sql).Scan(&n); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return n
+}
+
+// TestImport tests that importing a second export replaces the tables in the
+// evesde schema, keeps the version history and removes the staging schemas.
+func TestImport(t *testing.T) {
+	p := dbtest.New(t)
+	defer p.Close()
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	_, err = c.Exec(`DROP SCHEMA IF EXISTS evesde CASCADE`)
+	p.Release(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	i := evedb.NewImporter(p, log.New(log.Config{Level: "fatal"}))
+	e := evedb.New(p)
+
+	importSDE(t, i, sdeFiles)
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM evesde."invTypes" WHERE "typeName" = 'Tritanium'`); n != 1 {
+		t.Errorf("expected Tritanium to be imported, got %d rows", n)
+	}
+
+	files := make(map[string]string)
+	for name, content := range sdeFiles {
+		files[name] = strings.Replace(content, "en: Tritanium", "en: Tritanium II", 1)
+	}
+	s := importSDE(t, i, files)
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM evesde."invTypes" WHERE "typeName" = 'Tritanium'`); n != 0 {
+		t.Errorf("expected previous export to be replaced, got %d rows", n)
+	}
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM evesde."invTypes" WHERE "typeName" = 'Tritanium II'`); n != 1 {
+		t.Errorf("expected new export to be imported, got %d rows", n)
+	}
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM evesde."sdeVersion"`); n != 2 {
+		t.Errorf("expected both versions to be recorded, got %d", n)
+	}
+	v, err := e.Version()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if v != s.Version {
+		t.Errorf("expected version %s, got %s", s.Version, v)
+	}
+	n := queryInt(t, p, `SELECT COUNT(*) FROM pg_namespace WHERE nspname IN ('evesde_import', 'evesde_old')`)
+	if n != 0 {
+		t.Errorf("expected staging schemas to be removed, got %d", n)
+	}
+}
@@ -0,0 +1,280 @@
+package evedb
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx"
+)
+
+// An ActivityType is a kind of industry job performed using a blueprint.
+type ActivityType int
+
+// Supported industry activities.
+const (
+	ActivityManufacturing    ActivityType = 1
+	ActivityResearchTime     ActivityType = 3
+	ActivityResearchMaterial ActivityType = 4
+	ActivityCopying          ActivityType = 5
+	ActivityInvention        ActivityType = 8
+	ActivityReaction         ActivityType = 11
+)
+
+// String returns a human readable name for the activity.
+func (a ActivityType) String() string {
+	switch a {
+	case ActivityManufacturing:
+		return "Manufacturing"
+	case ActivityResearchTime:
+		return "Time Efficiency Research"
+	case ActivityResearchMaterial:
+		return "Material Efficiency Research"
+	case ActivityCopying:
+		return "Copying"
+	case ActivityInvention:
+		return "Invention"
+	case ActivityReaction:
+		return "Reactions"
+	}
+	return "Activity " + strconv.Itoa(int(a))
+}
+
+// A Blueprint describes the industry activities that can be performed using
+// a blueprint or reaction formula.
+type Blueprint struct {
+	*ItemType
+	// Maximum number of runs on a single copy.
+	MaxProductionLimit int         `json:"max_production_limit"`
+	Activities         []*Activity `json:"activities"`
+}
+
+// Activity returns the given activity, or nil if the activity cannot be
+// performed using the blueprint.
+func (b *Blueprint) Activity(t ActivityType) *Activity {
+	for _, a := range b.Activities {
+		if a.Type == t {
+			return a
+		}
+	}
+	return nil
+}
+
+// An Activity describes the requirements and results of a single run of an
+// industry job.
+type Activity struct {
+	Type ActivityType `json:"type"`
+	// Time a single run takes, before any bonuses are applied.
+	Time time.Duration `json:"time"`
+
+	Materials []*Material        `json:"materials"`
+	Products  []*ActivityProduct `json:"products"`
+	Skills    []*ActivitySkill   `json:"skills"`
+}
+
+// An ActivityProduct is a type and quantity of an item produced by an activity.
+type ActivityProduct struct {
+	*ItemType
+	Quantity int `json:"quantity"`
+	// Chance of success, between 0 and 1. Only invention has a chance of failure.
+	Probability float64 `json:"probability"`
+}
+
+// An ActivitySkill is a skill and level required to perform an activity.
+type ActivitySkill struct {
+	*ItemType
+	Level int `json:"level"`
+}
+
+// GetIndustryBlueprint fetches the activities that can be performed using the
+// given blueprint type.
+func (e *EveDB) GetIndustryBlueprint(blueprintTypeID int) (*Blueprint, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	return getIndustryBlueprint(c, blueprintTypeID)
+}
+
+// GetIndustryBlueprintByProduct fetches the blueprint that produces the given
+// type using the given activity.
+//
+// For example, the product of an invention is a tech 2 blueprint, while the
+// product of manufacturing is the item itself.
+func (e *EveDB) GetIndustryBlueprintByProduct(productTypeID int, activity ActivityType) (*Blueprint, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	id, err := blueprintIDForProduct(c, productTypeID, activity)
+	if err != nil {
+		return nil, err
+	}
+	return getIndustryBlueprint(c, id)
+}
+
+// blueprintIDForProduct returns the ID of the blueprint that produces the
+// given type using one of the given activities.
+//
+// pgx.ErrNoRows is returned if no such blueprint exists.
+func blueprintIDForProduct(c *pgx.Conn, productTypeID int, activities ...ActivityType) (int, error) {
+	var acts []int32
+	for _, a := range activities {
+		acts = append(acts, int32(a))
+	}
+	var id int
+	err := c.QueryRow(
+		`SELECT p."typeID"
+			FROM evesde."industryActivityProducts" p
+			WHERE p."productTypeID" = $1
+			  AND p."activityID" = ANY($2)
+			ORDER BY p."activityID", p."typeID"
+			LIMIT 1`, productTypeID, acts).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func getIndustryBlueprint(c *pgx.Conn, typeID int) (*Blueprint, error) {
+	bp := &Blueprint{ItemType: &ItemType{}}
+	err := c.QueryRow(
+		`SELECT
+			  typ."typeID"
+			, typ."typeName"
+			, COALESCE(typ."description", '')
+			, COALESCE(bp."maxProductionLimit", 0)
+			FROM evesde."industryBlueprints" bp
+			INNER JOIN evesde."invTypes" typ ON typ."typeID" = bp."typeID"
+			WHERE bp."typeID" = $1`, typeID).Scan(&bp.ID, &bp.Name, &bp.Description, &bp.MaxProductionLimit)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := c.Query(
+		`SELECT
+			  act."activityID"
+			, COALESCE(act."time", 0)
+			FROM evesde."industryActivity" act
+			WHERE act."typeID" = $1
+			ORDER BY act."activityID"`, typeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	acts := make(map[ActivityType]*Activity)
+	for rs.Next() {
+		var t, secs int
+		if err := rs.Scan(&t, &secs); err != nil {
+			return nil, err
+		}
+		a := &Activity{Type: ActivityType(t), Time: time.Duration(secs) * time.Second}
+		acts[a.Type] = a
+		bp.Activities = append(bp.Activities, a)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	// activity returns the activity of the given type, adding it to the
+	// blueprint if it was not listed in industryActivity.
+	activity := func(t ActivityType) *Activity {
+		if a, ok := acts[t]; ok {
+			return a
+		}
+		a := &Activity{Type: t}
+		acts[t] = a
+		bp.Activities = append(bp.Activities, a)
+		return a
+	}
+
+	rs, err = c.Query(
+		`SELECT
+			  mats."activityID"
+			, typ."typeID"
+			, typ."typeName"
+			, COALESCE(mats."quantity", 0)
+			FROM evesde."industryActivityMaterials" mats
+			INNER JOIN evesde."invTypes" typ ON typ."typeID" = mats."materialTypeID"
+			WHERE mats."typeID" = $1
+			ORDER BY mats."activityID", typ."typeID"`, typeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var t int
+		r := &Material{ItemType: &ItemType{}}
+		if err := rs.Scan(&t, &r.ID, &r.Name, &r.Quantity); err != nil {
+			return nil, err
+		}
+		a := activity(ActivityType(t))
+		a.Materials = append(a.Materials, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+
+	rs, err = c.Query(
+		`SELECT
+			  prod."activityID"
+			, typ."typeID"
+			, typ."typeName"
+			, COALESCE(prod."quantity", 0)
+			, COALESCE(prob."probability", 1)::DOUBLE PRECISION
+			FROM evesde."industryActivityProducts" prod
+			INNER JOIN evesde."invTypes" typ ON typ."typeID" = prod."productTypeID"
+			LEFT JOIN evesde."industryActivityProbabilities" prob
+			  ON prob."typeID" = prod."typeID"
+			  AND prob."activityID" = prod."activityID"
+			  AND prob."productTypeID" = prod."productTypeID"
+			WHERE prod."typeID" = $1
+			ORDER BY prod."activityID", typ."typeID"`, typeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var t int
+		r := &ActivityProduct{ItemType: &ItemType{}}
+		if err := rs.Scan(&t, &r.ID, &r.Name, &r.Quantity, &r.Probability); err != nil {
+			return nil, err
+		}
+		a := activity(ActivityType(t))
+		a.Products = append(a.Products, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+
+	rs, err = c.Query(
+		`SELECT
+			  skill."activityID"
+			, typ."typeID"
+			, typ."typeName"
+			, COALESCE(skill."level", 0)
+			FROM evesde."industryActivitySkills" skill
+			INNER JOIN evesde."invTypes" typ ON typ."typeID" = skill."skillID"
+			WHERE skill."typeID" = $1
+			ORDER BY skill."activityID", typ."typeID"`, typeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	for rs.Next() {
+		var t int
+		r := &ActivitySkill{ItemType: &ItemType{}}
+		if err := rs.Scan(&t, &r.ID, &r.Name, &r.Level); err != nil {
+			return nil, err
+		}
+		a := activity(ActivityType(t))
+		a.Skills = append(a.Skills, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(bp.Activities, func(i, j int) bool {
+		return bp.Activities[i].Type < bp.Activities[j].Type
+	})
+	return bp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jackc/pgx"
 	"github.com/shopspring/decimal"
 )
 
@@ -43,7 +44,7 @@ FROM evesde."invTypes" type
 
 // GetItemType fetches a specific ItemType from the database.
 func (e *EveDB) GetItemType(typeID int) (*ItemType, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +75,7 @@ var InterestingItemCategoriesAndBlueprints = []int{
 
 // QueryItemTypes returns a list of matching items given the query.
 func (e *EveDB) QueryItemTypes(query string, catIDs ...int) ([]*ItemType, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +138,7 @@ FROM evesde."invTypes" type
 
 // GetItemTypeDetail fetches a specific ItemType with extra details from the database.
 func (e *EveDB) GetItemTypeDetail(typeID int) (*ItemTypeDetail, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +164,7 @@ func (e *EveDB) GetItemTypeDetail(typeID int) (*ItemTypeDetail, error) {
 
 // QueryItemTypeDetails returns a list of matching items given the query.
 func (e *EveDB) QueryItemTypeDetails(query string, catIDs ...int) ([]*ItemTypeDetail, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -214,48 +215,53 @@ type MaterialSheet struct {
 	ProducesQty int         `json:"produces_qty"`
 }
 
-// A Material is a type and quantity of an item used for manufacturing.
+// A Material is a type and quantity of an item used in an industry activity.
 type Material struct {
 	*ItemType
 	Quantity int `json:"quantity"`
 }
 
 // GetBlueprint fetches a MaterialSheet from the database.
+//
+// The materials are those required by a single manufacturing run of the
+// blueprint that produces the given type, or a single reaction run if the type
+// is produced by a reaction formula. A type that cannot be produced has no
+// materials.
 func (e *EveDB) GetBlueprint(typeID int) (*MaterialSheet, error) {
 	it, err := e.GetItemTypeDetail(typeID)
 	if err != nil {
 		return nil, err
 	}
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
 	defer e.pool.Release(c)
-	rs, err := c.Query(
-		`SELECT
-			  typ."typeName" as typeName
-			, typ."typeID" as materialID
-			, mats."quantity"
-			FROM evesde."invTypeMaterials" mats
-			INNER JOIN evesde."invTypes" typ ON typ."typeID" = mats."materialTypeID"
-			WHERE mats."typeID" = $1`, it.ID)
+	bpID, err := blueprintIDForProduct(c, it.ID, ActivityManufacturing, ActivityReaction)
+	if err == pgx.ErrNoRows {
+		return &MaterialSheet{ItemType: it.ItemType, ProducesQty: it.PortionSize}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	bp, err := getIndustryBlueprint(c, bpID)
 	if err != nil {
 		return nil, err
 	}
-	defer rs.Close()
-	var res []*Material
-	for rs.Next() {
-		r := &Material{ItemType: &ItemType{}}
-		err := rs.Scan(&r.Name, &r.ID, &r.Quantity)
-		if err != nil {
-			return nil, err
-		}
-		res = append(res, r)
+	act := bp.Activity(ActivityManufacturing)
+	if act == nil {
+		act = bp.Activity(ActivityReaction)
 	}
-	if err = rs.Err(); err != nil {
-		return nil, err
+	res := &MaterialSheet{ItemType: it.ItemType, ProducesQty: it.PortionSize}
+	if act == nil {
+		return res, nil
 	}
-	return &MaterialSheet{ItemType: it.ItemType, ProducesQty: it.PortionSize, Materials: res}, nil
+	res.Materials = act.Materials
+	for _, p := range act.Products {
+		if p.ID == it.ID {
+			res.ProducesQty = p.Quantity
+		}
+	}
+	return res, nil
 }
 
 // GetBlueprints is a utility function to retrieve multiple Blueprints.
@@ -270,3 +276,40 @@ func (e *EveDB) GetBlueprints(typeIDs ...int) ([]*MaterialSheet, error) {
 	}
 	return res, nil
 }
+
+// GetReprocessingMaterials fetches the materials produced by reprocessing a
+// single portion of the given type with perfect yield.
+//
+// A type that cannot be reprocessed has no materials.
+func (e *EveDB) GetReprocessingMaterials(typeID int) ([]*Material, error) {
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	rs, err := c.Query(
+		`SELECT
+			  typ."typeID"
+			, typ."typeName"
+			, COALESCE(mats."quantity", 0)
+			FROM evesde."invTypeMaterials" mats
+			INNER JOIN evesde."invTypes" typ ON typ."typeID" = mats."materialTypeID"
+			WHERE mats."typeID" = $1
+			ORDER BY typ."typeID"`, typeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var res []*Material
+	for rs.Next() {
+		r := &Material{ItemType: &ItemType{}}
+		if err := rs.Scan(&r.ID, &r.Name, &r.Quantity); err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,311 @@
+package evedb
+
+import (
+	"container/heap"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoRoute is returned when there is no route between two solar systems.
+var ErrNoRoute = errors.New("evedb: no route between solar systems")
+
+// A RouteFlag determines how a route is chosen.
+type RouteFlag string
+
+// Supported route flags.
+const (
+	// RouteShortest chooses the route with the fewest jumps.
+	RouteShortest RouteFlag = "shortest"
+	// RouteSecure chooses the shortest route that stays in high security
+	// space, if one exists.
+	RouteSecure RouteFlag = "secure"
+	// RouteInsecure chooses the shortest route that stays out of high
+	// security space, if one exists.
+	RouteInsecure RouteFlag = "insecure"
+)
+
+// routePenalty is the cost of entering an undesired system when choosing a
+// secure or insecure route. It is greater than the length of any route, so a
+// route through undesired systems is chosen only if there is no alternative.
+const routePenalty = 10000
+
+// highSec returns true if the system is in high security space.
+//
+// Security status is displayed rounded to one decimal, so a system with a
+// security of 0.45 is considered high security.
+func highSec(s *System) bool {
+	return s.Security >= 0.45
+}
+
+// cost returns the cost of jumping into the given system, or an error if the
+// flag is not valid.
+func (f RouteFlag) cost() (func(*System) int, error) {
+	switch f {
+	case RouteShortest:
+		return func(*System) int { return 1 }, nil
+	case RouteSecure:
+		return func(s *System) int {
+			if highSec(s) {
+				return 1
+			}
+			return routePenalty
+		}, nil
+	case RouteInsecure:
+		return func(s *System) int {
+			if highSec(s) {
+				return routePenalty
+			}
+			return 1
+		}, nil
+	}
+	return nil, errors.Errorf("evedb: invalid route flag %q", f)
+}
+
+// A Route is a path between two solar systems.
+type Route struct {
+	Flag RouteFlag `json:"flag"`
+	// Each system along the route, including the origin and destination.
+	Systems []*System `json:"systems"`
+}
+
+// Jumps returns the number of jumps in the route.
+func (r *Route) Jumps() int {
+	if len(r.Systems) == 0 {
+		return 0
+	}
+	return len(r.Systems) - 1
+}
+
+// A Jump is a stargate connection from one solar system to another.
+type Jump struct {
+	FromSystemID int `json:"from_system_id"`
+	ToSystemID   int `json:"to_system_id"`
+}
+
+// A JumpGraph contains the stargate connections between solar systems.
+type JumpGraph struct {
+	systems map[int]*System
+	jumps   map[int][]int
+}
+
+// NewJumpGraph creates a JumpGraph from the given systems and jumps.
+//
+// Jumps to or from a system that is not in systems are ignored.
+func NewJumpGraph(systems []*System, jumps []Jump) *JumpGraph {
+	g := &JumpGraph{
+		systems: make(map[int]*System, len(systems)),
+		jumps:   make(map[int][]int, len(systems)),
+	}
+	for _, s := range systems {
+		g.systems[s.SystemID] = s
+	}
+	for _, j := range jumps {
+		if g.systems[j.FromSystemID] == nil || g.systems[j.ToSystemID] == nil {
+			continue
+		}
+		g.jumps[j.FromSystemID] = append(g.jumps[j.FromSystemID], j.ToSystemID)
+	}
+	for _, to := range g.jumps {
+		sort.Ints(to)
+	}
+	return g
+}
+
+// Route returns the route between the origin and destination systems chosen
+// according to the given flag. If flag is empty, RouteShortest is used.
+//
+// The route does not pass through any of the avoided systems. The origin and
+// destination themselves are never avoided. ErrNoRoute is returned if no
+// route exists.
+func (g *JumpGraph) Route(origin, destination int, flag RouteFlag, avoid ...int) (*Route, error) {
+	if flag == "" {
+		flag = RouteShortest
+	}
+	cost, err := flag.cost()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range []int{origin, destination} {
+		if g.systems[id] == nil {
+			return nil, errors.Errorf("evedb: unknown solar system %d", id)
+		}
+	}
+	avoided := make(map[int]bool)
+	for _, id := range avoid {
+		if id != origin && id != destination {
+			avoided[id] = true
+		}
+	}
+	dist := map[int]int{origin: 0}
+	prev := make(map[int]int)
+	q := &routeQueue{{origin, 0}}
+	for q.Len() > 0 {
+		n := heap.Pop(q).(routeNode)
+		if n.id == destination {
+			break
+		}
+		if n.cost > dist[n.id] {
+			continue
+		}
+		for _, next := range g.jumps[n.id] {
+			if avoided[next] {
+				continue
+			}
+			c := n.cost + cost(g.systems[next])
+			if d, ok := dist[next]; ok && d <= c {
+				continue
+			}
+			dist[next] = c
+			prev[next] = n.id
+			heap.Push(q, routeNode{next, c})
+		}
+	}
+	if _, ok := dist[destination]; !ok {
+		return nil, ErrNoRoute
+	}
+	var path []*System
+	for id := destination; id != origin; id = prev[id] {
+		path = append(path, g.systems[id])
+	}
+	path = append(path, g.systems[origin])
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return &Route{Flag: flag, Systems: path}, nil
+}
+
+// Jumps returns the number of jumps in the shortest route between the origin
+// and destination systems.
+func (g *JumpGraph) Jumps(origin, destination int) (int, error) {
+	r, err := g.Route(origin, destination, RouteShortest)
+	if err != nil {
+		return 0, err
+	}
+	return r.Jumps(), nil
+}
+
+// routeNode is a system and the cost of reaching it.
+type routeNode struct {
+	id   int
+	cost int
+}
+
+// routeQueue is a priority queue of routeNodes, ordered by cost.
+type routeQueue []routeNode
+
+func (q routeQueue) Len() int { return len(q) }
+func (q routeQueue) Less(i, j int) bool {
+	if q[i].cost == q[j].cost {
+		return q[i].id < q[j].id
+	}
+	return q[i].cost < q[j].cost
+}
+func (q routeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *routeQueue) Push(x interface{}) { *q = append(*q, x.(routeNode)) }
+func (q *routeQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
+
+// graphCheckInterval is how often GetJumpGraph checks whether a different
+// static dump has been installed.
+const graphCheckInterval = 5 * time.Minute
+
+// GetJumpGraph returns the stargate connections between all solar systems.
+//
+// The graph is loaded from the database once and reused until a different
+// static dump is installed, as reported by Version. The version is checked at
+// most once every graphCheckInterval.
+func (e *EveDB) GetJumpGraph() (*JumpGraph, error) {
+	e.graphMu.Lock()
+	defer e.graphMu.Unlock()
+	if e.graph != nil && time.Since(e.graphCheckedAt) < graphCheckInterval {
+		return e.graph, nil
+	}
+	v, err := e.Version()
+	if err != nil {
+		return nil, err
+	}
+	if e.graph != nil && e.graphVersion == v {
+		e.graphCheckedAt = time.Now()
+		return e.graph, nil
+	}
+	c, err := e.pool.OpenRead()
+	if err != nil {
+		return nil, err
+	}
+	defer e.pool.Release(c)
+	rs, err := c.Query(
+		`SELECT
+			  s."solarSystemID"
+			, s."constellationID"
+			, s."regionID"
+			, s."solarSystemName"
+			, s."security"
+			FROM evesde."mapSolarSystems" s`)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var systems []*System
+	for rs.Next() {
+		r := &System{}
+		err := rs.Scan(&r.SystemID, &r.ConstellationID, &r.RegionID, &r.Name, &r.Security)
+		if err != nil {
+			return nil, err
+		}
+		systems = append(systems, r)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	rs, err = c.Query(
+		`SELECT
+			  j."fromSolarSystemID"
+			, j."toSolarSystemID"
+			FROM evesde."mapSolarSystemJumps" j`)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var jumps []Jump
+	for rs.Next() {
+		var j Jump
+		if err := rs.Scan(&j.FromSystemID, &j.ToSystemID); err != nil {
+			return nil, err
+		}
+		jumps = append(jumps, j)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	e.graph = NewJumpGraph(systems, jumps)
+	e.graphVersion = v
+	e.graphCheckedAt = time.Now()
+	return e.graph, nil
+}
+
+// GetRoute returns the route between the origin and destination systems.
+//
+// See JumpGraph.Route for details.
+func (e *EveDB) GetRoute(origin, destination int, flag RouteFlag, avoid ...int) (*Route, error) {
+	g, err := e.GetJumpGraph()
+	if err != nil {
+		return nil, err
+	}
+	return g.Route(origin, destination, flag, avoid...)
+}
+
+// GetJumps returns the number of jumps in the shortest route between the
+// origin and destination systems.
+func (e *EveDB) GetJumps(origin, destination int) (int, error) {
+	g, err := e.GetJumpGraph()
+	if err != nil {
+		return 0, err
+	}
+	return g.Jumps(origin, destination)
+}
@@ -0,0 +1,110 @@
+package evedb_test
+
+import (
+	"testing"
+
+	"github.com/motki/core/evedb"
+)
+
+// newTestGraph returns a graph with a short lowsec route and a longer highsec
+// route between systems 1 and 5:
+//
+//	1 (1.0) - 2 (0.3) - 5 (0.9)
+//	1 (1.0) - 3 (0.7) - 4 (0.5) - 5 (0.9)
+func newTestGraph() *evedb.JumpGraph {
+	systems := []*evedb.System{
+		{SystemID: 1, Security: 1.0},
+		{SystemID: 2, Security: 0.3},
+		{SystemID: 3, Security: 0.7},
+		{SystemID: 4, Security: 0.45},
+		{SystemID: 5, Security: 0.9},
+		{SystemID: 6, Security: 0.5},
+	}
+	var jumps []evedb.Jump
+	for _, j := range [][2]int{{1, 2}, {2, 5}, {1, 3}, {3, 4}, {4, 5}} {
+		jumps = append(jumps,
+			evedb.Jump{FromSystemID: j[0], ToSystemID: j[1]},
+			evedb.Jump{FromSystemID: j[1], ToSystemID: j[0]})
+	}
+	return evedb.NewJumpGraph(systems, jumps)
+}
+
+func routeIDs(r *evedb.Route) []int {
+	var res []int
+	for _, s := range r.Systems {
+		res = append(res, s.SystemID)
+	}
+	return res
+}
+
+func TestJumpGraphRoute(t *testing.T) {
+	g := newTestGraph()
+	tests := []struct {
+		name  string
+		flag  evedb.RouteFlag
+		avoid []int
+		want  []int
+	}{
+		{"shortest", evedb.RouteShortest, nil, []int{1, 2, 5}},
+		{"default", "", nil, []int{1, 2, 5}},
+		{"secure", evedb.RouteSecure, nil, []int{1, 3, 4, 5}},
+		{"insecure", evedb.RouteInsecure, nil, []int{1, 2, 5}},
+		{"avoid", evedb.RouteShortest, []int{2}, []int{1, 3, 4, 5}},
+		{"avoid origin", evedb.RouteShortest, []int{1}, []int{1, 2, 5}},
+	}
+	for _, test := range tests {
+		r, err := g.Route(1, 5, test.flag, test.avoid...)
+		if err != nil {
+			t.Errorf("%s: expected no error, got %s", test.name, err)
+			continue
+		}
+		got := routeIDs(r)
+		if len(got) != len(test.want) {
+			t.Errorf("%s: expected route %v, got %v", test.name, test.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: expected route %v, got %v", test.name, test.want, got)
+				break
+			}
+		}
+		if r.Jumps() != len(test.want)-1 {
+			t.Errorf("%s: expected %d jumps, got %d", test.name, len(test.want)-1, r.Jumps())
+		}
+	}
+}
+
+func TestJumpGraphNoRoute(t *testing.T) {
+	g := newTestGraph()
+	if _, err := g.Route(1, 6, evedb.RouteShortest); err != evedb.ErrNoRoute {
+		t.Errorf("expected ErrNoRoute, got %v", err)
+	}
+	if _, err := g.Route(1, 5, evedb.RouteShortest, 2, 3); err != evedb.ErrNoRoute {
+		t.Errorf("expected ErrNoRoute when avoiding, got %v", err)
+	}
+	if _, err := g.Route(1, 5, "fastest"); err == nil {
+		t.Errorf("expected error for invalid flag")
+	}
+	if _, err := g.Route(1, 7, evedb.RouteShortest); err == nil {
+		t.Errorf("expected error for unknown system")
+	}
+}
+
+func TestJumpGraphJumps(t *testing.T) {
+	g := newTestGraph()
+	n, err := g.Jumps(1, 4)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 jumps, got %d", n)
+	}
+	n, err = g.Jumps(3, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if n != 0 {
+		t.Errorf("expected 0 jumps, got %d", n)
+	}
+}
@@ -0,0 +1,1347 @@
+package evedb
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// An SDE is CCP's static data export, converted into the tables queried by
+// EveDB.
+type SDE struct {
+	// Version identifies the build of the export.
+	Version string
+	// Checksum is the hex encoded SHA-256 of the export archive.
+	Checksum string
+
+	Tables []*SDETable
+}
+
+// An SDETable contains the rows of a single table in the evesde schema.
+type SDETable struct {
+	Name    string
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// Table returns the table with the given name, or nil if no such table exists.
+func (s *SDE) Table(name string) *SDETable {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// sdeColumn is a column in an evesde table.
+type sdeColumn struct {
+	name string
+	typ  string
+}
+
+// sdeTableDef describes an evesde table created by the importer.
+type sdeTableDef struct {
+	name    string
+	columns []sdeColumn
+	// Columns in the primary key, if any.
+	key []string
+	// Columns that are individually indexed.
+	indexes []string
+}
+
+// columnNames returns the names of each column in the table.
+func (d sdeTableDef) columnNames() []string {
+	var res []string
+	for _, c := range d.columns {
+		res = append(res, c.name)
+	}
+	return res
+}
+
+// sdeTables defines each table in the evesde schema, in the order they are
+// created.
+//
+// Only the columns queried by EveDB are included; they are named and typed
+// the same as in Fuzzwork's Postgres conversion so that either may be used.
+var sdeTables = []sdeTableDef{
+	{
+		name: "invCategories",
+		columns: []sdeColumn{
+			{"categoryID", "INTEGER NOT NULL"},
+			{"categoryName", "VARCHAR(100)"},
+			{"iconID", "INTEGER"},
+			{"published", "BOOLEAN"},
+		},
+		key: []string{"categoryID"},
+	},
+	{
+		name: "invGroups",
+		columns: []sdeColumn{
+			{"groupID", "INTEGER NOT NULL"},
+			{"categoryID", "INTEGER"},
+			{"groupName", "VARCHAR(100)"},
+			{"iconID", "INTEGER"},
+			{"published", "BOOLEAN"},
+		},
+		key:     []string{"groupID"},
+		indexes: []string{"categoryID"},
+	},
+	{
+		name: "invTypes",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"groupID", "INTEGER"},
+			{"typeName", "VARCHAR(100)"},
+			{"description", "TEXT"},
+			{"mass", "DOUBLE PRECISION"},
+			{"volume", "DOUBLE PRECISION"},
+			{"capacity", "DOUBLE PRECISION"},
+			{"portionSize", "INTEGER"},
+			{"raceID", "INTEGER"},
+			{"basePrice", "NUMERIC(19,4)"},
+			{"published", "BOOLEAN"},
+			{"marketGroupID", "INTEGER"},
+			{"iconID", "INTEGER"},
+		},
+		key:     []string{"typeID"},
+		indexes: []string{"groupID", "typeName"},
+	},
+	{
+		name: "invMetaTypes",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"parentTypeID", "INTEGER"},
+			{"metaGroupID", "INTEGER"},
+		},
+		key:     []string{"typeID"},
+		indexes: []string{"parentTypeID"},
+	},
+	{
+		name: "invTypeMaterials",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"materialTypeID", "INTEGER NOT NULL"},
+			{"quantity", "INTEGER NOT NULL"},
+		},
+		key: []string{"typeID", "materialTypeID"},
+	},
+	{
+		name: "eveIcons",
+		columns: []sdeColumn{
+			{"iconID", "INTEGER NOT NULL"},
+			{"iconFile", "VARCHAR(500)"},
+			{"description", "TEXT"},
+		},
+		key: []string{"iconID"},
+	},
+	{
+		name: "chrRaces",
+		columns: []sdeColumn{
+			{"raceID", "INTEGER NOT NULL"},
+			{"raceName", "VARCHAR(100)"},
+			{"description", "TEXT"},
+			{"iconID", "INTEGER"},
+			{"shortDescription", "TEXT"},
+		},
+		key: []string{"raceID"},
+	},
+	{
+		name: "chrAncestries",
+		columns: []sdeColumn{
+			{"ancestryID", "INTEGER NOT NULL"},
+			{"ancestryName", "VARCHAR(100)"},
+			{"bloodlineID", "INTEGER"},
+			{"description", "TEXT"},
+			{"iconID", "INTEGER"},
+			{"shortDescription", "TEXT"},
+		},
+		key: []string{"ancestryID"},
+	},
+	{
+		name: "chrBloodlines",
+		columns: []sdeColumn{
+			{"bloodlineID", "INTEGER NOT NULL"},
+			{"bloodlineName", "VARCHAR(100)"},
+			{"raceID", "INTEGER"},
+			{"description", "TEXT"},
+			{"iconID", "INTEGER"},
+			{"shortDescription", "TEXT"},
+			{"corporationID", "INTEGER"},
+		},
+		key: []string{"bloodlineID"},
+	},
+	{
+		name: "industryBlueprints",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"maxProductionLimit", "INTEGER"},
+		},
+		key: []string{"typeID"},
+	},
+	{
+		name: "industryActivity",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"activityID", "INTEGER NOT NULL"},
+			{"time", "INTEGER"},
+		},
+		key: []string{"typeID", "activityID"},
+	},
+	{
+		name: "industryActivityMaterials",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"activityID", "INTEGER NOT NULL"},
+			{"materialTypeID", "INTEGER NOT NULL"},
+			{"quantity", "INTEGER"},
+		},
+		indexes: []string{"typeID"},
+	},
+	{
+		name: "industryActivityProducts",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"activityID", "INTEGER NOT NULL"},
+			{"productTypeID", "INTEGER NOT NULL"},
+			{"quantity", "INTEGER"},
+		},
+		indexes: []string{"typeID", "productTypeID"},
+	},
+	{
+		name: "industryActivityProbabilities",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"activityID", "INTEGER NOT NULL"},
+			{"productTypeID", "INTEGER NOT NULL"},
+			{"probability", "NUMERIC(3,2)"},
+		},
+		indexes: []string{"typeID"},
+	},
+	{
+		name: "industryActivitySkills",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"activityID", "INTEGER NOT NULL"},
+			{"skillID", "INTEGER NOT NULL"},
+			{"level", "INTEGER"},
+		},
+		indexes: []string{"typeID"},
+	},
+	{
+		name: "dgmAttributeTypes",
+		columns: []sdeColumn{
+			{"attributeID", "INTEGER NOT NULL"},
+			{"attributeName", "VARCHAR(100)"},
+			{"description", "VARCHAR(1000)"},
+			{"iconID", "INTEGER"},
+			{"defaultValue", "DOUBLE PRECISION"},
+			{"published", "BOOLEAN"},
+			{"displayName", "VARCHAR(150)"},
+			{"unitID", "INTEGER"},
+			{"stackable", "BOOLEAN"},
+			{"highIsGood", "BOOLEAN"},
+			{"categoryID", "INTEGER"},
+		},
+		key: []string{"attributeID"},
+	},
+	{
+		name: "dgmTypeAttributes",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"attributeID", "INTEGER NOT NULL"},
+			{"valueInt", "INTEGER"},
+			{"valueFloat", "DOUBLE PRECISION"},
+		},
+		key:     []string{"typeID", "attributeID"},
+		indexes: []string{"attributeID"},
+	},
+	{
+		name: "dgmEffects",
+		columns: []sdeColumn{
+			{"effectID", "INTEGER NOT NULL"},
+			{"effectName", "VARCHAR(400)"},
+			{"effectCategory", "INTEGER"},
+			{"description", "VARCHAR(1000)"},
+			{"published", "BOOLEAN"},
+			{"displayName", "VARCHAR(100)"},
+		},
+		key: []string{"effectID"},
+	},
+	{
+		name: "dgmTypeEffects",
+		columns: []sdeColumn{
+			{"typeID", "INTEGER NOT NULL"},
+			{"effectID", "INTEGER NOT NULL"},
+			{"isDefault", "BOOLEAN"},
+		},
+		key:     []string{"typeID", "effectID"},
+		indexes: []string{"effectID"},
+	},
+	{
+		name: "mapRegions",
+		columns: []sdeColumn{
+			{"regionID", "INTEGER NOT NULL"},
+			{"regionName", "VARCHAR(100)"},
+		},
+		key: []string{"regionID"},
+	},
+	{
+		name: "mapConstellations",
+		columns: []sdeColumn{
+			{"constellationID", "INTEGER NOT NULL"},
+			{"regionID", "INTEGER"},
+			{"constellationName", "VARCHAR(100)"},
+		},
+		key:     []string{"constellationID"},
+		indexes: []string{"regionID"},
+	},
+	{
+		name: "mapSolarSystems",
+		columns: []sdeColumn{
+			{"solarSystemID", "INTEGER NOT NULL"},
+			{"constellationID", "INTEGER"},
+			{"regionID", "INTEGER"},
+			{"solarSystemName", "VARCHAR(100)"},
+			{"security", "DOUBLE PRECISION"},
+		},
+		key:     []string{"solarSystemID"},
+		indexes: []string{"constellationID", "regionID"},
+	},
+	{
+		name: "mapSolarSystemJumps",
+		columns: []sdeColumn{
+			{"fromRegionID", "INTEGER"},
+			{"fromConstellationID", "INTEGER"},
+			{"fromSolarSystemID", "INTEGER NOT NULL"},
+			{"toSolarSystemID", "INTEGER NOT NULL"},
+			{"toConstellationID", "INTEGER"},
+			{"toRegionID", "INTEGER"},
+		},
+		key: []string{"fromSolarSystemID", "toSolarSystemID"},
+	},
+	{
+		name: "staStations",
+		columns: []sdeColumn{
+			{"stationID", "BIGINT NOT NULL"},
+			{"stationTypeID", "INTEGER"},
+			{"stationName", "VARCHAR(100)"},
+			{"solarSystemID", "INTEGER"},
+			{"constellationID", "INTEGER"},
+			{"regionID", "INTEGER"},
+			{"corporationID", "INTEGER"},
+			{"security", "DOUBLE PRECISION"},
+		},
+		key:     []string{"stationID"},
+		indexes: []string{"solarSystemID"},
+	},
+}
+
+// sdeFileVersion matches the build date in the name of an export archive,
+// such as "sde-20180529-TRANQUILITY.zip".
+var sdeFileVersion = regexp.MustCompile(`(\d{8})`)
+
+// LoadSDE reads the static data export in the zip archive at the given path.
+//
+// The version of the export is taken from the build date in the file name if
+// present, otherwise it is the checksum of the archive.
+func LoadSDE(filename string) (*SDE, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	s, err := ReadSDE(f, fi.Size())
+	if err != nil {
+		return nil, err
+	}
+	if m := sdeFileVersion.FindString(filepath.Base(filename)); m != "" {
+		s.Version = m
+	}
+	return s, nil
+}
+
+// ReadSDE reads a static data export zip archive of the given size.
+//
+// The archive is expected to have the layout of CCP's YAML distribution, with
+// "fsd" and "bsd" directories optionally inside a top-level "sde" directory.
+// The icon and character creation files are optional; their tables are empty
+// if they are missing. The version of the returned SDE is the checksum of the
+// archive.
+//
+// The rows of every table are held in memory. The large bsd files are decoded
+// one item at a time, so only the resulting rows, not the parsed documents,
+// are held for those.
+func ReadSDE(r io.ReaderAt, size int64) (*SDE, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, io.NewSectionReader(r, 0, size)); err != nil {
+		return nil, errors.Wrap(err, "evedb: unable to read sde")
+	}
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "evedb: unable to open sde")
+	}
+	sr := &sdeReader{files: make(map[string]*zip.File)}
+	for _, f := range zr.File {
+		sr.files[strings.TrimPrefix(f.Name, "sde/")] = f
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	s := &SDE{Version: sum, Checksum: sum}
+	for _, load := range []func() (*SDETable, error){
+		sr.categories,
+		sr.groups,
+		sr.types,
+		sr.metaTypes,
+		sr.typeMaterials,
+		sr.icons,
+		sr.races,
+		sr.ancestries,
+		sr.bloodlines,
+		sr.blueprints,
+		sr.activities,
+		sr.activityMaterials,
+		sr.activityProducts,
+		sr.activityProbabilities,
+		sr.activitySkills,
+		sr.attributeTypes,
+		sr.typeAttributes,
+		sr.effects,
+		sr.typeEffects,
+		sr.regions,
+		sr.constellations,
+		sr.solarSystems,
+		sr.jumps,
+		sr.stations,
+	} {
+		t, err := load()
+		if err != nil {
+			return nil, err
+		}
+		s.Tables = append(s.Tables, t)
+	}
+	return s, nil
+}
+
+// sdeReader decodes the files in an export archive.
+type sdeReader struct {
+	files map[string]*zip.File
+
+	itemTypes      map[int]*sdeType
+	itemBlueprints map[int]*sdeBlueprint
+	names          map[int]string
+	universe       *sdeUniverse
+}
+
+// decode decodes the named YAML file into v.
+//
+// If the file does not exist and is not required, decode returns false.
+func (r *sdeReader) decode(name string, v interface{}, required bool) (bool, error) {
+	f, ok := r.files[name]
+	if !ok {
+		if required {
+			return false, errors.Errorf("evedb: sde is missing %s", name)
+		}
+		return false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return false, errors.Wrapf(err, "evedb: unable to open %s", name)
+	}
+	defer rc.Close()
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return false, errors.Wrapf(err, "evedb: unable to read %s", name)
+	}
+	if err := yaml.Unmarshal(b, v); err != nil {
+		return false, errors.Wrapf(err, "evedb: unable to decode %s", name)
+	}
+	return true, nil
+}
+
+// decodeEach decodes the named YAML file, which must contain a sequence, one
+// item at a time. fn is called for each item with a function that decodes the
+// item into v.
+//
+// Unlike decode, the file is never held in memory in full, which greatly
+// reduces the memory needed to read the large bsd files. Items must begin
+// with "-" at the start of a line, as in CCP's export.
+//
+// If the file does not exist and is not required, decodeEach returns false.
+func (r *sdeReader) decodeEach(name string, required bool, fn func(decode func(v interface{}) error) error) (bool, error) {
+	f, ok := r.files[name]
+	if !ok {
+		if required {
+			return false, errors.Errorf("evedb: sde is missing %s", name)
+		}
+		return false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return false, errors.Wrapf(err, "evedb: unable to open %s", name)
+	}
+	defer rc.Close()
+	var item []byte
+	started := false
+	flush := func() error {
+		if !started {
+			return nil
+		}
+		err := fn(func(v interface{}) error { return yaml.Unmarshal(item, v) })
+		item = item[:0]
+		return err
+	}
+	br := bufio.NewReader(rc)
+	for {
+		line, rerr := br.ReadBytes('\n')
+		if len(line) > 0 && line[0] == '-' {
+			if err := flush(); err != nil {
+				return false, errors.Wrapf(err, "evedb: unable to decode %s", name)
+			}
+			// Replacing the dash leaves the item as an indented mapping.
+			line[0] = ' '
+			started = true
+		}
+		if started {
+			item = append(item, line...)
+		} else if l := bytes.TrimSpace(line); len(l) > 0 && l[0] != '#' && string(l) != "[]" {
+			return false, errors.Errorf("evedb: unable to decode %s: expected a sequence", name)
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return false, errors.Wrapf(rerr, "evedb: unable to read %s", name)
+		}
+	}
+	if err := flush(); err != nil {
+		return false, errors.Wrapf(err, "evedb: unable to decode %s", name)
+	}
+	return true, nil
+}
+
+// newTable returns an empty table for the named definition.
+func newTable(name string) *SDETable {
+	for _, d := range sdeTables {
+		if d.name == name {
+			return &SDETable{Name: name, Columns: d.columnNames()}
+		}
+	}
+	panic("evedb: undefined sde table " + name)
+}
+
+// sortedIDs returns the keys of m in ascending order.
+func sortedIDs(m map[int]bool) []int {
+	var res []int
+	for id := range m {
+		res = append(res, id)
+	}
+	sort.Ints(res)
+	return res
+}
+
+// intOrNil returns the value of i, or nil if i is nil.
+func intOrNil(i *int) interface{} {
+	if i == nil {
+		return nil
+	}
+	return *i
+}
+
+// floatOrNil returns the value of f, or nil if f is nil.
+func floatOrNil(f *float64) interface{} {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+// sdeText is a localized string in the export.
+type sdeText map[string]string
+
+// String returns the English translation of the text.
+func (t sdeText) String() string {
+	return t["en"]
+}
+
+type sdeCategory struct {
+	Name      sdeText `yaml:"name"`
+	IconID    *int    `yaml:"iconID"`
+	Published bool    `yaml:"published"`
+}
+
+func (r *sdeReader) categories() (*SDETable, error) {
+	var v map[int]*sdeCategory
+	if _, err := r.decode("fsd/categoryIDs.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("invCategories")
+	ids := make(map[int]bool)
+	for id := range v {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		c := v[id]
+		t.Rows = append(t.Rows, []interface{}{id, c.Name.String(), intOrNil(c.IconID), c.Published})
+	}
+	return t, nil
+}
+
+type sdeGroup struct {
+	CategoryID int     `yaml:"categoryID"`
+	Name       sdeText `yaml:"name"`
+	IconID     *int    `yaml:"iconID"`
+	Published  bool    `yaml:"published"`
+}
+
+func (r *sdeReader) groups() (*SDETable, error) {
+	var v map[int]*sdeGroup
+	if _, err := r.decode("fsd/groupIDs.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("invGroups")
+	ids := make(map[int]bool)
+	for id := range v {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		g := v[id]
+		t.Rows = append(t.Rows, []interface{}{id, g.CategoryID, g.Name.String(), intOrNil(g.IconID), g.Published})
+	}
+	return t, nil
+}
+
+type sdeType struct {
+	GroupID               int      `yaml:"groupID"`
+	Name                  sdeText  `yaml:"name"`
+	Description           sdeText  `yaml:"description"`
+	Mass                  *float64 `yaml:"mass"`
+	Volume                *float64 `yaml:"volume"`
+	Capacity              *float64 `yaml:"capacity"`
+	PortionSize           int      `yaml:"portionSize"`
+	RaceID                *int     `yaml:"raceID"`
+	BasePrice             *float64 `yaml:"basePrice"`
+	Published             bool     `yaml:"published"`
+	MarketGroupID         *int     `yaml:"marketGroupID"`
+	IconID                *int     `yaml:"iconID"`
+	MetaGroupID           *int     `yaml:"metaGroupID"`
+	VariationParentTypeID *int     `yaml:"variationParentTypeID"`
+}
+
+func (r *sdeReader) types() (*SDETable, error) {
+	if _, err := r.decode("fsd/typeIDs.yaml", &r.itemTypes, true); err != nil {
+		return nil, err
+	}
+	t := newTable("invTypes")
+	ids := make(map[int]bool)
+	for id := range r.itemTypes {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		it := r.itemTypes[id]
+		t.Rows = append(t.Rows, []interface{}{
+			id,
+			it.GroupID,
+			it.Name.String(),
+			it.Description.String(),
+			floatOrNil(it.Mass),
+			floatOrNil(it.Volume),
+			floatOrNil(it.Capacity),
+			it.PortionSize,
+			intOrNil(it.RaceID),
+			floatOrNil(it.BasePrice),
+			it.Published,
+			intOrNil(it.MarketGroupID),
+			intOrNil(it.IconID),
+		})
+	}
+	return t, nil
+}
+
+type sdeMetaType struct {
+	TypeID       int  `yaml:"typeID"`
+	ParentTypeID *int `yaml:"parentTypeID"`
+	MetaGroupID  *int `yaml:"metaGroupID"`
+}
+
+// metaTypes returns the meta types from the legacy invMetaTypes file if
+// present, otherwise from the variation parents of each type.
+func (r *sdeReader) metaTypes() (*SDETable, error) {
+	t := newTable("invMetaTypes")
+	var v []sdeMetaType
+	ok, err := r.decode("bsd/invMetaTypes.yaml", &v, false)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		sort.Slice(v, func(i, j int) bool { return v[i].TypeID < v[j].TypeID })
+		for _, m := range v {
+			t.Rows = append(t.Rows, []interface{}{m.TypeID, intOrNil(m.ParentTypeID), intOrNil(m.MetaGroupID)})
+		}
+		return t, nil
+	}
+	ids := make(map[int]bool)
+	for id, it := range r.itemTypes {
+		if it.VariationParentTypeID != nil {
+			ids[id] = true
+		}
+	}
+	for _, id := range sortedIDs(ids) {
+		it := r.itemTypes[id]
+		t.Rows = append(t.Rows, []interface{}{id, *it.VariationParentTypeID, intOrNil(it.MetaGroupID)})
+	}
+	return t, nil
+}
+
+type sdeTypeMaterials struct {
+	Materials []struct {
+		MaterialTypeID int `yaml:"materialTypeID"`
+		Quantity       int `yaml:"quantity"`
+	} `yaml:"materials"`
+}
+
+func (r *sdeReader) typeMaterials() (*SDETable, error) {
+	var v map[int]*sdeTypeMaterials
+	if _, err := r.decode("fsd/typeMaterials.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("invTypeMaterials")
+	ids := make(map[int]bool)
+	for id := range v {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		for _, m := range v[id].Materials {
+			t.Rows = append(t.Rows, []interface{}{id, m.MaterialTypeID, m.Quantity})
+		}
+	}
+	return t, nil
+}
+
+type sdeIcon struct {
+	IconFile    string `yaml:"iconFile"`
+	Description string `yaml:"description"`
+}
+
+func (r *sdeReader) icons() (*SDETable, error) {
+	var v map[int]*sdeIcon
+	if _, err := r.decode("fsd/iconIDs.yaml", &v, false); err != nil {
+		return nil, err
+	}
+	t := newTable("eveIcons")
+	ids := make(map[int]bool)
+	for id := range v {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		t.Rows = append(t.Rows, []interface{}{id, v[id].IconFile, v[id].Description})
+	}
+	return t, nil
+}
+
+type sdeRace struct {
+	RaceID           int    `yaml:"raceID"`
+	RaceName         string `yaml:"raceName"`
+	Description      string `yaml:"description"`
+	IconID           *int   `yaml:"iconID"`
+	ShortDescription string `yaml:"shortDescription"`
+}
+
+func (r *sdeReader) races() (*SDETable, error) {
+	var v []sdeRace
+	if _, err := r.decode("bsd/chrRaces.yaml", &v, false); err != nil {
+		return nil, err
+	}
+	t := newTable("chrRaces")
+	sort.Slice(v, func(i, j int) bool { return v[i].RaceID < v[j].RaceID })
+	for _, c := range v {
+		t.Rows = append(t.Rows, []interface{}{c.RaceID, c.RaceName, c.Description, intOrNil(c.IconID), c.ShortDescription})
+	}
+	return t, nil
+}
+
+type sdeAncestry struct {
+	AncestryID       int    `yaml:"ancestryID"`
+	AncestryName     string `yaml:"ancestryName"`
+	BloodlineID      int    `yaml:"bloodlineID"`
+	Description      string `yaml:"description"`
+	IconID           *int   `yaml:"iconID"`
+	ShortDescription string `yaml:"shortDescription"`
+}
+
+func (r *sdeReader) ancestries() (*SDETable, error) {
+	var v []sdeAncestry
+	if _, err := r.decode("bsd/chrAncestries.yaml", &v, false); err != nil {
+		return nil, err
+	}
+	t := newTable("chrAncestries")
+	sort.Slice(v, func(i, j int) bool { return v[i].AncestryID < v[j].AncestryID })
+	for _, c := range v {
+		t.Rows = append(t.Rows, []interface{}{c.AncestryID, c.AncestryName, c.BloodlineID, c.Description, intOrNil(c.IconID), c.ShortDescription})
+	}
+	return t, nil
+}
+
+type sdeBloodline struct {
+	BloodlineID      int    `yaml:"bloodlineID"`
+	BloodlineName    string `yaml:"bloodlineName"`
+	RaceID           int    `yaml:"raceID"`
+	Description      string `yaml:"description"`
+	IconID           *int   `yaml:"iconID"`
+	ShortDescription string `yaml:"shortDescription"`
+	CorporationID    int    `yaml:"corporationID"`
+}
+
+func (r *sdeReader) bloodlines() (*SDETable, error) {
+	var v []sdeBloodline
+	if _, err := r.decode("bsd/chrBloodlines.yaml", &v, false); err != nil {
+		return nil, err
+	}
+	t := newTable("chrBloodlines")
+	sort.Slice(v, func(i, j int) bool { return v[i].BloodlineID < v[j].BloodlineID })
+	for _, c := range v {
+		t.Rows = append(t.Rows, []interface{}{c.BloodlineID, c.BloodlineName, c.RaceID, c.Description, intOrNil(c.IconID), c.ShortDescription, c.CorporationID})
+	}
+	return t, nil
+}
+
+// sdeActivities maps the name of each activity in the export to its type.
+var sdeActivities = map[string]ActivityType{
+	"manufacturing":     ActivityManufacturing,
+	"research_time":     ActivityResearchTime,
+	"research_material": ActivityResearchMaterial,
+	"copying":           ActivityCopying,
+	"invention":         ActivityInvention,
+	"reaction":          ActivityReaction,
+}
+
+type sdeActivity struct {
+	Time      int `yaml:"time"`
+	Materials []struct {
+		TypeID   int `yaml:"typeID"`
+		Quantity int `yaml:"quantity"`
+	} `yaml:"materials"`
+	Products []struct {
+		TypeID      int      `yaml:"typeID"`
+		Quantity    int      `yaml:"quantity"`
+		Probability *float64 `yaml:"probability"`
+	} `yaml:"products"`
+	Skills []struct {
+		TypeID int `yaml:"typeID"`
+		Level  int `yaml:"level"`
+	} `yaml:"skills"`
+}
+
+type sdeBlueprint struct {
+	MaxProductionLimit int                     `yaml:"maxProductionLimit"`
+	Activities         map[string]*sdeActivity `yaml:"activities"`
+}
+
+// loadBlueprints decodes the blueprints in the export.
+func (r *sdeReader) loadBlueprints() (map[int]*sdeBlueprint, error) {
+	if r.itemBlueprints != nil {
+		return r.itemBlueprints, nil
+	}
+	if _, err := r.decode("fsd/blueprints.yaml", &r.itemBlueprints, true); err != nil {
+		return nil, err
+	}
+	return r.itemBlueprints, nil
+}
+
+// eachActivity calls fn for each known activity of each blueprint, ordered by
+// blueprint type ID and then activity type.
+func (r *sdeReader) eachActivity(fn func(typeID int, act ActivityType, a *sdeActivity)) error {
+	bps, err := r.loadBlueprints()
+	if err != nil {
+		return err
+	}
+	ids := make(map[int]bool)
+	for id := range bps {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		var acts []ActivityType
+		byType := make(map[ActivityType]*sdeActivity)
+		for name, a := range bps[id].Activities {
+			act, ok := sdeActivities[name]
+			if !ok || a == nil {
+				continue
+			}
+			acts = append(acts, act)
+			byType[act] = a
+		}
+		sort.Slice(acts, func(i, j int) bool { return acts[i] < acts[j] })
+		for _, act := range acts {
+			fn(id, act, byType[act])
+		}
+	}
+	return nil
+}
+
+func (r *sdeReader) blueprints() (*SDETable, error) {
+	bps, err := r.loadBlueprints()
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("industryBlueprints")
+	ids := make(map[int]bool)
+	for id := range bps {
+		ids[id] = true
+	}
+	for _, id := range sortedIDs(ids) {
+		t.Rows = append(t.Rows, []interface{}{id, bps[id].MaxProductionLimit})
+	}
+	return t, nil
+}
+
+func (r *sdeReader) activities() (*SDETable, error) {
+	t := newTable("industryActivity")
+	err := r.eachActivity(func(typeID int, act ActivityType, a *sdeActivity) {
+		t.Rows = append(t.Rows, []interface{}{typeID, int(act), a.Time})
+	})
+	return t, err
+}
+
+func (r *sdeReader) activityMaterials() (*SDETable, error) {
+	t := newTable("industryActivityMaterials")
+	err := r.eachActivity(func(typeID int, act ActivityType, a *sdeActivity) {
+		for _, m := range a.Materials {
+			t.Rows = append(t.Rows, []interface{}{typeID, int(act), m.TypeID, m.Quantity})
+		}
+	})
+	return t, err
+}
+
+func (r *sdeReader) activityProducts() (*SDETable, error) {
+	t := newTable("industryActivityProducts")
+	err := r.eachActivity(func(typeID int, act ActivityType, a *sdeActivity) {
+		for _, p := range a.Products {
+			t.Rows = append(t.Rows, []interface{}{typeID, int(act), p.TypeID, p.Quantity})
+		}
+	})
+	return t, err
+}
+
+func (r *sdeReader) activityProbabilities() (*SDETable, error) {
+	t := newTable("industryActivityProbabilities")
+	err := r.eachActivity(func(typeID int, act ActivityType, a *sdeActivity) {
+		for _, p := range a.Products {
+			if p.Probability != nil {
+				t.Rows = append(t.Rows, []interface{}{typeID, int(act), p.TypeID, *p.Probability})
+			}
+		}
+	})
+	return t, err
+}
+
+func (r *sdeReader) activitySkills() (*SDETable, error) {
+	t := newTable("industryActivitySkills")
+	err := r.eachActivity(func(typeID int, act ActivityType, a *sdeActivity) {
+		for _, s := range a.Skills {
+			t.Rows = append(t.Rows, []interface{}{typeID, int(act), s.TypeID, s.Level})
+		}
+	})
+	return t, err
+}
+
+type sdeAttributeType struct {
+	AttributeID   int     `yaml:"attributeID"`
+	AttributeName string  `yaml:"attributeName"`
+	Description   string  `yaml:"description"`
+	IconID        *int    `yaml:"iconID"`
+	DefaultValue  float64 `yaml:"defaultValue"`
+	Published     bool    `yaml:"published"`
+	DisplayName   string  `yaml:"displayName"`
+	UnitID        *int    `yaml:"unitID"`
+	Stackable     bool    `yaml:"stackable"`
+	HighIsGood    bool    `yaml:"highIsGood"`
+	CategoryID    *int    `yaml:"categoryID"`
+}
+
+func (r *sdeReader) attributeTypes() (*SDETable, error) {
+	var v []sdeAttributeType
+	if _, err := r.decode("bsd/dgmAttributeTypes.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("dgmAttributeTypes")
+	sort.Slice(v, func(i, j int) bool { return v[i].AttributeID < v[j].AttributeID })
+	for _, a := range v {
+		t.Rows = append(t.Rows, []interface{}{
+			a.AttributeID,
+			a.AttributeName,
+			a.Description,
+			intOrNil(a.IconID),
+			a.DefaultValue,
+			a.Published,
+			a.DisplayName,
+			intOrNil(a.UnitID),
+			a.Stackable,
+			a.HighIsGood,
+			intOrNil(a.CategoryID),
+		})
+	}
+	return t, nil
+}
+
+type sdeTypeAttribute struct {
+	TypeID      int      `yaml:"typeID"`
+	AttributeID int      `yaml:"attributeID"`
+	ValueInt    *int     `yaml:"valueInt"`
+	ValueFloat  *float64 `yaml:"valueFloat"`
+}
+
+func (r *sdeReader) typeAttributes() (*SDETable, error) {
+	var v []sdeTypeAttribute
+	_, err := r.decodeEach("bsd/dgmTypeAttributes.yaml", true, func(decode func(interface{}) error) error {
+		var a sdeTypeAttribute
+		if err := decode(&a); err != nil {
+			return err
+		}
+		v = append(v, a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("dgmTypeAttributes")
+	sort.Slice(v, func(i, j int) bool {
+		if v[i].TypeID == v[j].TypeID {
+			return v[i].AttributeID < v[j].AttributeID
+		}
+		return v[i].TypeID < v[j].TypeID
+	})
+	for _, a := range v {
+		t.Rows = append(t.Rows, []interface{}{a.TypeID, a.AttributeID, intOrNil(a.ValueInt), floatOrNil(a.ValueFloat)})
+	}
+	return t, nil
+}
+
+type sdeEffect struct {
+	EffectID       int    `yaml:"effectID"`
+	EffectName     string `yaml:"effectName"`
+	EffectCategory int    `yaml:"effectCategory"`
+	Description    string `yaml:"description"`
+	Published      bool   `yaml:"published"`
+	DisplayName    string `yaml:"displayName"`
+}
+
+func (r *sdeReader) effects() (*SDETable, error) {
+	var v []sdeEffect
+	if _, err := r.decode("bsd/dgmEffects.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("dgmEffects")
+	sort.Slice(v, func(i, j int) bool { return v[i].EffectID < v[j].EffectID })
+	for _, e := range v {
+		t.Rows = append(t.Rows, []interface{}{e.EffectID, e.EffectName, e.EffectCategory, e.Description, e.Published, e.DisplayName})
+	}
+	return t, nil
+}
+
+type sdeTypeEffect struct {
+	TypeID    int  `yaml:"typeID"`
+	EffectID  int  `yaml:"effectID"`
+	IsDefault bool `yaml:"isDefault"`
+}
+
+func (r *sdeReader) typeEffects() (*SDETable, error) {
+	var v []sdeTypeEffect
+	_, err := r.decodeEach("bsd/dgmTypeEffects.yaml", true, func(decode func(interface{}) error) error {
+		var e sdeTypeEffect
+		if err := decode(&e); err != nil {
+			return err
+		}
+		v = append(v, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("dgmTypeEffects")
+	sort.Slice(v, func(i, j int) bool {
+		if v[i].TypeID == v[j].TypeID {
+			return v[i].EffectID < v[j].EffectID
+		}
+		return v[i].TypeID < v[j].TypeID
+	})
+	for _, e := range v {
+		t.Rows = append(t.Rows, []interface{}{e.TypeID, e.EffectID, e.IsDefault})
+	}
+	return t, nil
+}
+
+// sdeUniverse contains the regions, constellations and solar systems in the
+// export, keyed by the directory that contains each.
+type sdeUniverse struct {
+	regions        map[string]int
+	constellations map[string]int
+	systems        map[string]*sdeSolarSystem
+}
+
+type sdeSolarSystem struct {
+	SolarSystemID int     `yaml:"solarSystemID"`
+	Security      float64 `yaml:"security"`
+	Stargates     map[int]struct {
+		Destination int `yaml:"destination"`
+	} `yaml:"stargates"`
+}
+
+// loadUniverse decodes the static data for every region, constellation and
+// solar system in the export, as well as their names.
+func (r *sdeReader) loadUniverse() (*sdeUniverse, error) {
+	if r.universe != nil {
+		return r.universe, nil
+	}
+	r.names = make(map[int]string)
+	_, err := r.decodeEach("bsd/invNames.yaml", true, func(decode func(interface{}) error) error {
+		var n struct {
+			ItemID   int    `yaml:"itemID"`
+			ItemName string `yaml:"itemName"`
+		}
+		if err := decode(&n); err != nil {
+			return err
+		}
+		r.names[n.ItemID] = n.ItemName
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	u := &sdeUniverse{
+		regions:        make(map[string]int),
+		constellations: make(map[string]int),
+		systems:        make(map[string]*sdeSolarSystem),
+	}
+	for name := range r.files {
+		if !strings.HasPrefix(name, "fsd/universe/") {
+			continue
+		}
+		dir := path.Dir(name)
+		switch path.Base(name) {
+		case "region.staticdata":
+			var v struct {
+				RegionID int `yaml:"regionID"`
+			}
+			if _, err := r.decode(name, &v, true); err != nil {
+				return nil, err
+			}
+			u.regions[dir] = v.RegionID
+		case "constellation.staticdata":
+			var v struct {
+				ConstellationID int `yaml:"constellationID"`
+			}
+			if _, err := r.decode(name, &v, true); err != nil {
+				return nil, err
+			}
+			u.constellations[dir] = v.ConstellationID
+		case "solarsystem.staticdata":
+			v := &sdeSolarSystem{}
+			if _, err := r.decode(name, v, true); err != nil {
+				return nil, err
+			}
+			u.systems[dir] = v
+		}
+	}
+	if len(u.systems) == 0 {
+		return nil, errors.New("evedb: sde is missing fsd/universe")
+	}
+	r.universe = u
+	return u, nil
+}
+
+// name returns the name of the given item, falling back to the name of the
+// directory containing it.
+func (r *sdeReader) name(id int, dir string) string {
+	if n, ok := r.names[id]; ok {
+		return n
+	}
+	return path.Base(dir)
+}
+
+// sortedDirs returns the keys of m ordered by their IDs.
+func sortedDirs(m map[string]int) []string {
+	var res []string
+	for dir := range m {
+		res = append(res, dir)
+	}
+	sort.Slice(res, func(i, j int) bool { return m[res[i]] < m[res[j]] })
+	return res
+}
+
+func (r *sdeReader) regions() (*SDETable, error) {
+	u, err := r.loadUniverse()
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("mapRegions")
+	for _, dir := range sortedDirs(u.regions) {
+		id := u.regions[dir]
+		t.Rows = append(t.Rows, []interface{}{id, r.name(id, dir)})
+	}
+	return t, nil
+}
+
+func (r *sdeReader) constellations() (*SDETable, error) {
+	u, err := r.loadUniverse()
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("mapConstellations")
+	for _, dir := range sortedDirs(u.constellations) {
+		id := u.constellations[dir]
+		regionID, ok := u.regions[path.Dir(dir)]
+		if !ok {
+			return nil, errors.Errorf("evedb: sde has no region for constellation %d", id)
+		}
+		t.Rows = append(t.Rows, []interface{}{id, regionID, r.name(id, dir)})
+	}
+	return t, nil
+}
+
+// solarSystemLocation returns the constellation and region of the solar
+// system in the given directory.
+func (u *sdeUniverse) solarSystemLocation(dir string) (constID, regionID int, err error) {
+	s := u.systems[dir]
+	constDir := path.Dir(dir)
+	constID, ok := u.constellations[constDir]
+	if !ok {
+		return 0, 0, errors.Errorf("evedb: sde has no constellation for solar system %d", s.SolarSystemID)
+	}
+	regionID, ok = u.regions[path.Dir(constDir)]
+	if !ok {
+		return 0, 0, errors.Errorf("evedb: sde has no region for solar system %d", s.SolarSystemID)
+	}
+	return constID, regionID, nil
+}
+
+// sortedSystemDirs returns the directory of each solar system ordered by the
+// solar system's ID.
+func (u *sdeUniverse) sortedSystemDirs() []string {
+	var dirs []string
+	for dir := range u.systems {
+		dirs = append(dirs, dir)
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		return u.systems[dirs[i]].SolarSystemID < u.systems[dirs[j]].SolarSystemID
+	})
+	return dirs
+}
+
+func (r *sdeReader) solarSystems() (*SDETable, error) {
+	u, err := r.loadUniverse()
+	if err != nil {
+		return nil, err
+	}
+	t := newTable("mapSolarSystems")
+	for _, dir := range u.sortedSystemDirs() {
+		s := u.systems[dir]
+		constID, regionID, err := u.solarSystemLocation(dir)
+		if err != nil {
+			return nil, err
+		}
+		t.Rows = append(t.Rows, []interface{}{s.SolarSystemID, constID, regionID, r.name(s.SolarSystemID, dir), s.Security})
+	}
+	return t, nil
+}
+
+// jumps returns a row for each stargate connection between two solar systems.
+func (r *sdeReader) jumps() (*SDETable, error) {
+	u, err := r.loadUniverse()
+	if err != nil {
+		return nil, err
+	}
+	// Maps each stargate to the directory of the solar system containing it.
+	gates := make(map[int]string)
+	for dir, s := range u.systems {
+		for id := range s.Stargates {
+			gates[id] = dir
+		}
+	}
+	t := newTable("mapSolarSystemJumps")
+	for _, dir := range u.sortedSystemDirs() {
+		s := u.systems[dir]
+		fromConstID, fromRegionID, err := u.solarSystemLocation(dir)
+		if err != nil {
+			return nil, err
+		}
+		seen := make(map[int]bool)
+		var dests []string
+		for id, g := range s.Stargates {
+			destDir, ok := gates[g.Destination]
+			if !ok {
+				return nil, errors.Errorf("evedb: sde has no destination for stargate %d", id)
+			}
+			if destID := u.systems[destDir].SolarSystemID; !seen[destID] {
+				seen[destID] = true
+				dests = append(dests, destDir)
+			}
+		}
+		sort.Slice(dests, func(i, j int) bool {
+			return u.systems[dests[i]].SolarSystemID < u.systems[dests[j]].SolarSystemID
+		})
+		for _, destDir := range dests {
+			toConstID, toRegionID, err := u.solarSystemLocation(destDir)
+			if err != nil {
+				return nil, err
+			}
+			t.Rows = append(t.Rows, []interface{}{
+				fromRegionID,
+				fromConstID,
+				s.SolarSystemID,
+				u.systems[destDir].SolarSystemID,
+				toConstID,
+				toRegionID,
+			})
+		}
+	}
+	return t, nil
+}
+
+type sdeStation struct {
+	StationID       int     `yaml:"stationID"`
+	StationTypeID   int     `yaml:"stationTypeID"`
+	StationName     string  `yaml:"stationName"`
+	SolarSystemID   int     `yaml:"solarSystemID"`
+	ConstellationID int     `yaml:"constellationID"`
+	RegionID        int     `yaml:"regionID"`
+	CorporationID   int     `yaml:"corporationID"`
+	Security        float64 `yaml:"security"`
+}
+
+func (r *sdeReader) stations() (*SDETable, error) {
+	var v []sdeStation
+	if _, err := r.decode("bsd/staStations.yaml", &v, true); err != nil {
+		return nil, err
+	}
+	t := newTable("staStations")
+	sort.Slice(v, func(i, j int) bool { return v[i].StationID < v[j].StationID })
+	for _, s := range v {
+		t.Rows = append(t.Rows, []interface{}{
+			s.StationID,
+			s.StationTypeID,
+			s.StationName,
+			s.SolarSystemID,
+			s.ConstellationID,
+			s.RegionID,
+			s.CorporationID,
+			s.Security,
+		})
+	}
+	return t, nil
+}
@@ -0,0 +1,275 @@
+package evedb_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/motki/core/evedb"
+)
+
+var sdeFiles = map[string]string{
+	"sde/fsd/categoryIDs.yaml": `
+4:
+    name:
+        en: Material
+    published: true
+`,
+	"sde/fsd/groupIDs.yaml": `
+18:
+    categoryID: 4
+    name:
+        en: Mineral
+    published: true
+`,
+	"sde/fsd/typeIDs.yaml": `
+34:
+    groupID: 18
+    name:
+        de: Tritanium
+        en: Tritanium
+    description:
+        en: The main building block in space structures.
+    mass: 0.0
+    volume: 0.01
+    portionSize: 1
+    basePrice: 2.0
+    published: true
+35:
+    groupID: 18
+    name:
+        en: Pyerite
+    portionSize: 1
+    published: true
+    metaGroupID: 2
+    variationParentTypeID: 34
+`,
+	"sde/fsd/typeMaterials.yaml": `
+35:
+    materials:
+    -   materialTypeID: 34
+        quantity: 10
+`,
+	"sde/fsd/blueprints.yaml": `
+681:
+    activities:
+        copying:
+            time: 480
+        invention:
+            materials:
+            -   quantity: 2
+                typeID: 20418
+            products:
+            -   probability: 0.3
+                quantity: 1
+                typeID: 1178
+            skills:
+            -   level: 1
+                typeID: 3402
+            time: 63900
+        manufacturing:
+            materials:
+            -   quantity: 86
+                typeID: 34
+            products:
+            -   quantity: 1
+                typeID: 165
+            time: 600
+        research_material:
+            time: 210
+        research_time:
+            time: 210
+    blueprintTypeID: 681
+    maxProductionLimit: 300
+`,
+	"sde/bsd/invNames.yaml": `
+-   itemID: 10000002
+    itemName: The Forge
+-   itemID: 20000020
+    itemName: Kimotoro
+-   itemID: 30000142
+    itemName: Jita
+-   itemID: 30000144
+    itemName: Perimeter
+`,
+	"sde/bsd/dgmAttributeTypes.yaml": `
+-   attributeID: 182
+    attributeName: requiredSkill1
+    categoryID: 8
+    defaultValue: 0.0
+    displayName: Primary Skill required
+    highIsGood: true
+    published: true
+    stackable: true
+    unitID: 116
+-   attributeID: 50
+    attributeName: cpu
+    defaultValue: 0.0
+    displayName: CPU usage
+    highIsGood: false
+    iconID: 1405
+    published: true
+    stackable: true
+    unitID: 106
+`,
+	"sde/bsd/dgmTypeAttributes.yaml": `
+-   attributeID: 182
+    typeID: 35
+    valueInt: 3380
+-   attributeID: 50
+    typeID: 35
+    valueFloat: 12.5
+`,
+	"sde/bsd/dgmEffects.yaml": `
+-   effectCategory: 0
+    effectID: 12
+    effectName: hiPower
+    published: false
+`,
+	"sde/bsd/dgmTypeEffects.yaml": `
+-   effectID: 12
+    isDefault: false
+    typeID: 35
+`,
+	"sde/bsd/staStations.yaml": `
+-   constellationID: 20000020
+    corporationID: 1000035
+    regionID: 10000002
+    security: 0.945913116664839
+    solarSystemID: 30000142
+    stationID: 60003760
+    stationName: Jita IV - Moon 4 - Caldari Navy Assembly Plant
+    stationTypeID: 52678
+`,
+	"sde/fsd/universe/eve/TheForge/region.staticdata": `
+regionID: 10000002
+`,
+	"sde/fsd/universe/eve/TheForge/Kimotoro/constellation.staticdata": `
+constellationID: 20000020
+`,
+	"sde/fsd/universe/eve/TheForge/Kimotoro/Jita/solarsystem.staticdata": `
+security: 0.945913116664839
+solarSystemID: 30000142
+stargates:
+    50001248:
+        destination: 50001249
+        typeID: 29624
+`,
+	"sde/fsd/universe/eve/TheForge/Kimotoro/Perimeter/solarsystem.staticdata": `
+security: 0.954216111063791
+solarSystemID: 30000144
+stargates:
+    50001249:
+        destination: 50001248
+        typeID: 29624
+`,
+}
+
+func newSDEZip(t *testing.T, files map[string]string) *bytes.Reader {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("unable to create %s: %s", name, err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatalf("unable to write %s: %s", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unable to close zip: %s", err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadSDE(t *testing.T) {
+	r := newSDEZip(t, sdeFiles)
+	s, err := evedb.ReadSDE(r, r.Size())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if len(s.Checksum) != 64 || s.Version != s.Checksum {
+		t.Errorf("expected version to be the checksum, got %s and %s", s.Version, s.Checksum)
+	}
+	counts := map[string]int{
+		"invCategories":                 1,
+		"invGroups":                     1,
+		"invTypes":                      2,
+		"invMetaTypes":                  1,
+		"invTypeMaterials":              1,
+		"eveIcons":                      0,
+		"chrRaces":                      0,
+		"industryBlueprints":            1,
+		"industryActivity":              5,
+		"industryActivityMaterials":     2,
+		"industryActivityProducts":      2,
+		"industryActivityProbabilities": 1,
+		"industryActivitySkills":        1,
+		"dgmAttributeTypes":             2,
+		"dgmTypeAttributes":             2,
+		"dgmEffects":                    1,
+		"dgmTypeEffects":                1,
+		"mapRegions":                    1,
+		"mapConstellations":             1,
+		"mapSolarSystems":               2,
+		"mapSolarSystemJumps":           2,
+		"staStations":                   1,
+	}
+	for name, n := range counts {
+		tbl := s.Table(name)
+		if tbl == nil {
+			t.Errorf("expected table %s", name)
+			continue
+		}
+		if len(tbl.Rows) != n {
+			t.Errorf("expected %d rows in %s, got %d", n, name, len(tbl.Rows))
+		}
+	}
+
+	typ := s.Table("invTypes").Rows[0]
+	if typ[0] != 34 || typ[2] != "Tritanium" || typ[9] != 2.0 || typ[6] != nil {
+		t.Errorf("unexpected invTypes row: %v", typ)
+	}
+	meta := s.Table("invMetaTypes").Rows[0]
+	if meta[0] != 35 || meta[1] != 34 || meta[2] != 2 {
+		t.Errorf("unexpected invMetaTypes row: %v", meta)
+	}
+	act := s.Table("industryActivity").Rows
+	if act[0][1] != 1 || act[0][2] != 600 || act[4][1] != 8 {
+		t.Errorf("unexpected industryActivity rows: %v", act)
+	}
+	prob := s.Table("industryActivityProbabilities").Rows[0]
+	if prob[0] != 681 || prob[1] != 8 || prob[2] != 1178 || prob[3] != 0.3 {
+		t.Errorf("unexpected industryActivityProbabilities row: %v", prob)
+	}
+	attr := s.Table("dgmAttributeTypes").Rows[0]
+	if attr[0] != 50 || attr[1] != "cpu" || attr[3] != 1405 || attr[9] != false || attr[10] != nil {
+		t.Errorf("unexpected dgmAttributeTypes row: %v", attr)
+	}
+	val := s.Table("dgmTypeAttributes").Rows
+	if val[0][1] != 50 || val[0][2] != nil || val[0][3] != 12.5 || val[1][2] != 3380 || val[1][3] != nil {
+		t.Errorf("unexpected dgmTypeAttributes rows: %v", val)
+	}
+	jump := s.Table("mapSolarSystemJumps").Rows[0]
+	if jump[2] != 30000142 || jump[3] != 30000144 || jump[5] != 10000002 {
+		t.Errorf("unexpected mapSolarSystemJumps row: %v", jump)
+	}
+	sys := s.Table("mapSolarSystems").Rows[0]
+	if sys[0] != 30000142 || sys[1] != 20000020 || sys[2] != 10000002 || sys[3] != "Jita" {
+		t.Errorf("unexpected mapSolarSystems row: %v", sys)
+	}
+}
+
+func TestReadSDEMissingFile(t *testing.T) {
+	files := make(map[string]string)
+	for name, content := range sdeFiles {
+		if name != "sde/fsd/typeIDs.yaml" {
+			files[name] = content
+		}
+	}
+	r := newSDEZip(t, files)
+	if _, err := evedb.ReadSDE(r, r.Size()); err == nil {
+		t.Errorf("expected error for missing typeIDs.yaml")
+	}
+}
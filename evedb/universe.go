@@ -30,7 +30,7 @@ type Station struct {
 }
 
 func (e *EveDB) GetSystem(id int) (*System, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (e *EveDB) GetSystem(id int) (*System, error) {
 }
 
 func (e *EveDB) GetConstellation(id int) (*Constellation, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (e *EveDB) GetConstellation(id int) (*Constellation, error) {
 }
 
 func (e *EveDB) GetRegion(id int) (*Region, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +94,7 @@ func (e *EveDB) GetRegion(id int) (*Region, error) {
 }
 
 func (e *EveDB) GetAllRegions() ([]*Region, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +121,7 @@ func (e *EveDB) GetAllRegions() ([]*Region, error) {
 }
 
 func (e *EveDB) GetStation(stationID int) (*Station, error) {
-	c, err := e.pool.Open()
+	c, err := e.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
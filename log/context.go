@@ -0,0 +1,65 @@
+package log
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// Standard field names for request-scoped log entries.
+const (
+	FieldRequestID     = "request_id"
+	FieldMethod        = "method"
+	FieldUserID        = "user_id"
+	FieldCharacterID   = "character_id"
+	FieldCorporationID = "corporation_id"
+)
+
+type loggerKey struct{}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger Logger = logrus.StandardLogger()
+)
+
+// SetDefault sets the Logger returned by FromContext when a context does not
+// carry one.
+//
+// Applications should call SetDefault with their configured Logger at
+// startup, otherwise the logrus standard logger is used.
+func SetDefault(l Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
+
+// Default returns the Logger set with SetDefault.
+func Default() Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// NewContext returns a copy of ctx that carries the given Logger.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// WithFields returns a copy of ctx whose Logger is enriched with the given
+// fields, in addition to any fields already present.
+func WithFields(ctx context.Context, fields logrus.Fields) context.Context {
+	return NewContext(ctx, FromContext(ctx).WithFields(fields))
+}
+
+// FromContext returns the Logger carried by ctx.
+//
+// If ctx does not carry a Logger, the default Logger is returned.
+func FromContext(ctx context.Context) Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(loggerKey{}).(Logger); ok {
+			return l
+		}
+	}
+	return Default()
+}
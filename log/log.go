@@ -2,6 +2,7 @@
 package log // import "github.com/motki/core/log"
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
 	stdlog "log"
@@ -20,12 +21,31 @@ const (
 	OutputStdout outputType = iota
 	OutputStderr
 	OutputNull
+	OutputFile
+)
+
+// Supported log formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
 // Config contains information on how to configure a logger.
 type Config struct {
 	Level      string     `toml:"level"`
 	OutputType outputType `toml:"output_type"`
+
+	// Format of log entries, either "text" (the default) or "json".
+	// JSON entries are written one per line.
+	Format string `toml:"format"`
+
+	// Configures the log file when OutputType is OutputFile.
+	File FileConfig `toml:"file"`
+
+	// Level overrides by package, such as "debug" for "eveapi" or "warn" for
+	// "worker". Package paths are relative to motki/core; an override also
+	// applies to any packages beneath it.
+	Packages map[string]string `toml:"packages"`
 }
 
 // New creates and configures a new Logger using the given Config.
@@ -35,20 +55,55 @@ func New(c Config) Logger {
 		l = logrus.DebugLevel
 	}
 	logger := logrus.New()
+	var errs []string
 	switch c.OutputType {
 	case OutputStderr:
 		logger.Out = os.Stderr
 	case OutputNull:
 		logger.Out = ioutil.Discard
+	case OutputFile:
+		w, err := newRotateWriter(c.File)
+		if err != nil {
+			logger.Out = os.Stderr
+			errs = append(errs, fmt.Sprintf("unable to open log file '%s', logging to stderr: %s", c.File.Path, err.Error()))
+			break
+		}
+		logger.Out = w
 	default:
 		// do nothing.
 	}
 	logger.Level = l
-	logger.Formatter = &logrus.TextFormatter{}
+	switch c.Format {
+	case FormatJSON:
+		logger.Formatter = &logrus.JSONFormatter{}
+	case FormatText, "":
+		logger.Formatter = &logrus.TextFormatter{}
+	default:
+		logger.Formatter = &logrus.TextFormatter{}
+		errs = append(errs, fmt.Sprintf("invalid log format '%s', defaulting to '%s'", c.Format, FormatText))
+	}
+	if len(c.Packages) > 0 {
+		pf := &packageFormatter{Formatter: logger.Formatter, level: l, levels: make(map[string]logrus.Level)}
+		for pkg, lvl := range c.Packages {
+			pl, err := logrus.ParseLevel(lvl)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("invalid log level '%s' for package '%s', ignoring", lvl, pkg))
+				continue
+			}
+			pf.levels[pkg] = pl
+			if pl > logger.Level {
+				logger.Level = pl
+			}
+		}
+		logger.Formatter = pf
+	}
 	// Re-check for the above error and log it as a warning if it exist
 	if err != nil {
 		logger.Warnf("invalid log level '%s', defaulting to '%s'", c.Level, l.String())
 	}
+	for _, msg := range errs {
+		logger.Warn(msg)
+	}
 	return logger
 }
 
@@ -0,0 +1,210 @@
+package log_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/log"
+)
+
+// readLines returns the lines written to the given file.
+func readLines(t *testing.T, path string) []string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read log file: %s", err.Error())
+	}
+	s := strings.TrimSuffix(string(b), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// TestJSONFile tests that entries are written to a file as JSON lines.
+func TestJSONFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+
+	l := log.New(log.Config{
+		Level:      "info",
+		OutputType: log.OutputFile,
+		Format:     log.FormatJSON,
+		File:       log.FileConfig{Path: path},
+	})
+	l.WithField("region", 10000002).Info("hello")
+	l.Debug("hidden")
+
+	lines := readLines(t, path)
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d: %v", len(lines), lines)
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("expected valid JSON, got %s", err.Error())
+	}
+	if entry["msg"] != "hello" || entry["level"] != "info" || entry["region"] != float64(10000002) {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+}
+
+// TestPackageLevels tests that per-package level overrides apply to the
+// package that logged each entry.
+func TestPackageLevels(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+
+	conf := log.Config{
+		Level:      "warn",
+		OutputType: log.OutputFile,
+		File:       log.FileConfig{Path: path},
+		Packages:   map[string]string{"log_test": "debug"},
+	}
+	l := log.New(conf)
+	l.Debug("visible")
+	if lines := readLines(t, path); len(lines) != 1 || !strings.Contains(lines[0], "visible") {
+		t.Errorf("expected debug entry from overridden package, got %v", lines)
+	}
+
+	conf.Packages = map[string]string{"log_test": "error"}
+	l = log.New(conf)
+	l.Warn("hidden")
+	if lines := readLines(t, path); len(lines) != 1 {
+		t.Errorf("expected warn entry to be discarded, got %v", lines)
+	}
+}
+
+// TestRotate tests that the log file is rotated once it exceeds its maximum
+// size and that only the configured number of backups are kept.
+func TestRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+
+	l := log.New(log.Config{
+		Level:      "info",
+		OutputType: log.OutputFile,
+		File:       log.FileConfig{Path: path, MaxSize: 1, MaxBackups: 1},
+	})
+	msg := strings.Repeat("x", 300*1024)
+	for i := 0; i < 8; i++ {
+		l.Info(msg)
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "motki-*.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Errorf("expected 1 backup, got %d", len(backups))
+	}
+	if lines := readLines(t, path); len(lines) != 2 {
+		t.Errorf("expected 2 lines in current log file, got %d", len(lines))
+	}
+}
+
+// TestFromContext tests that fields added to a context are included in
+// entries logged with the context's Logger.
+func TestFromContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+
+	l := log.New(log.Config{
+		Level:      "info",
+		OutputType: log.OutputFile,
+		Format:     log.FormatJSON,
+		File:       log.FileConfig{Path: path},
+	})
+	ctx := log.NewContext(context.Background(), l.WithField(log.FieldRequestID, "abc123"))
+	ctx = log.WithFields(ctx, logrus.Fields{log.FieldCharacterID: 90000001})
+	log.FromContext(ctx).Info("hello")
+
+	lines := readLines(t, path)
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d: %v", len(lines), lines)
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("expected valid JSON, got %s", err.Error())
+	}
+	if entry[log.FieldRequestID] != "abc123" || entry[log.FieldCharacterID] != float64(90000001) {
+		t.Errorf("expected request fields, got %v", entry)
+	}
+}
+
+// TestFromContextDefault tests that the default Logger is used when a context
+// does not carry one.
+func TestFromContextDefault(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+
+	l := log.New(log.Config{
+		Level:      "info",
+		OutputType: log.OutputFile,
+		File:       log.FileConfig{Path: path},
+	})
+	defer log.SetDefault(log.Default())
+	log.SetDefault(l)
+	log.FromContext(context.Background()).Info("hello")
+
+	if lines := readLines(t, path); len(lines) != 1 {
+		t.Errorf("expected 1 line, got %d: %v", len(lines), lines)
+	}
+}
+
+// TestRotateOnOpen tests that an existing log file is rotated when it is
+// opened if the file is rotated by age.
+func TestRotateOnOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "motki.log")
+	if err = ioutil.WriteFile(path, []byte("{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	l := log.New(log.Config{
+		Level:      "info",
+		OutputType: log.OutputFile,
+		File:       log.FileConfig{Path: path, RotateHours: 24},
+	})
+	l.Info("after restart")
+
+	backups, err := filepath.Glob(filepath.Join(dir, "motki-*.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Errorf("expected 1 backup, got %d", len(backups))
+	}
+	if lines := readLines(t, path); len(lines) != 1 {
+		t.Errorf("expected 1 line in current log file, got %d", len(lines))
+	}
+}
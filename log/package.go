@@ -0,0 +1,76 @@
+package log
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// modulePrefix is trimmed from package paths when matching level overrides.
+const modulePrefix = "github.com/motki/core/"
+
+// packageFormatter discards entries that are below the level configured for
+// the package that logged them.
+//
+// The logger's level must be set to the most verbose of all configured levels
+// so that entries reach the formatter at all.
+type packageFormatter struct {
+	logrus.Formatter
+
+	level  logrus.Level            // Level for packages without an override.
+	levels map[string]logrus.Level // Overrides, by package path.
+}
+
+func (f *packageFormatter) Format(e *logrus.Entry) ([]byte, error) {
+	if e.Level > f.levelFor(callerPackage()) {
+		return nil, nil
+	}
+	return f.Formatter.Format(e)
+}
+
+// levelFor returns the level for the given package, using the override for
+// the longest matching package path. An override for "proto" also applies to
+// "proto/server".
+func (f *packageFormatter) levelFor(pkg string) logrus.Level {
+	for pkg != "" {
+		if l, ok := f.levels[pkg]; ok {
+			return l
+		}
+		i := strings.LastIndex(pkg, "/")
+		if i < 0 {
+			break
+		}
+		pkg = pkg[:i]
+	}
+	return f.level
+}
+
+// callerPackage returns the path of the package that logged the current entry,
+// relative to motki/core if it is a motki package.
+func callerPackage() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, "github.com/sirupsen/logrus") &&
+			!strings.HasPrefix(f.Function, modulePrefix+"log.") &&
+			!strings.HasPrefix(f.Function, "runtime.") {
+			return packagePath(f.Function)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// packagePath returns the package path of the given fully qualified function name.
+func packagePath(fn string) string {
+	fn = strings.TrimPrefix(fn, modulePrefix)
+	i := strings.LastIndex(fn, "/")
+	if j := strings.Index(fn[i+1:], "."); j >= 0 {
+		return fn[:i+1+j]
+	}
+	return fn
+}
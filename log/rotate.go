@@ -0,0 +1,182 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileConfig configures logging to a file.
+//
+// The file is rotated when it exceeds MaxSize megabytes or when it is older
+// than RotateHours hours, whichever comes first. Rotated files are renamed
+// to include the time of rotation, such as "motki-2018-03-01T15-04-05.000.log".
+type FileConfig struct {
+	Path string `toml:"path"`
+
+	// Maximum size of the file in megabytes before it is rotated. The file is
+	// not rotated by size if zero.
+	MaxSize int `toml:"max_size"`
+	// Maximum age of the file in hours before it is rotated. The file is not
+	// rotated by age if zero. If set, an existing file is rotated on startup.
+	RotateHours int `toml:"rotate_hours"`
+
+	// Maximum number of rotated files to keep. All are kept if zero.
+	MaxBackups int `toml:"max_backups"`
+	// Maximum age of rotated files in days. Files are kept regardless of age if zero.
+	MaxAgeDays int `toml:"max_age_days"`
+}
+
+// backupTimeFormat is used to name rotated files.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// rotateWriter is an io.WriteCloser that writes to a file, rotating it as
+// configured by a FileConfig.
+type rotateWriter struct {
+	conf FileConfig
+
+	mu     sync.Mutex
+	f      *os.File
+	size   int64
+	opened time.Time
+}
+
+// newRotateWriter opens the configured file for appending.
+//
+// If the file is rotated by age, an existing non-empty file is rotated first;
+// when it was first written is unknown, so appending to it would delay its
+// rotation by up to a full period each time the process restarts.
+func newRotateWriter(c FileConfig) (*rotateWriter, error) {
+	w := &rotateWriter{conf: c}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return nil, err
+	}
+	rotated := false
+	if c.RotateHours > 0 {
+		if info, err := os.Stat(c.Path); err == nil && info.Size() > 0 {
+			// Keep appending to the existing file if it cannot be renamed.
+			rotated = os.Rename(c.Path, w.backupName()) == nil
+		}
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	if rotated {
+		w.prune()
+	}
+	return w, nil
+}
+
+// backupName returns the name a file rotated now is renamed to.
+func (w *rotateWriter) backupName() string {
+	ext := filepath.Ext(w.conf.Path)
+	base := strings.TrimSuffix(w.conf.Path, ext)
+	return base + "-" + time.Now().Format(backupTimeFormat) + ext
+}
+
+// open opens the file, creating it if necessary.
+func (w *rotateWriter) open() error {
+	f, err := os.OpenFile(w.conf.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.f = f
+	w.size = info.Size()
+	w.opened = time.Now()
+	return nil
+}
+
+// due returns true if the file should be rotated before writing n bytes.
+func (w *rotateWriter) due(n int) bool {
+	if w.size == 0 {
+		return false
+	}
+	if max := int64(w.conf.MaxSize) * 1024 * 1024; max > 0 && w.size+int64(n) > max {
+		return true
+	}
+	if h := w.conf.RotateHours; h > 0 && time.Since(w.opened) > time.Duration(h)*time.Hour {
+		return true
+	}
+	return false
+}
+
+func (w *rotateWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.due(len(p)) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// rotate renames the current file, opens a new one and removes any rotated
+// files that should no longer be kept.
+func (w *rotateWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(w.conf.Path, w.backupName()); err != nil {
+		// Keep writing to the current file.
+		if errOpen := w.open(); errOpen != nil {
+			return errOpen
+		}
+		return err
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	w.prune()
+	return nil
+}
+
+// prune removes rotated files beyond the configured retention.
+func (w *rotateWriter) prune() {
+	if w.conf.MaxBackups <= 0 && w.conf.MaxAgeDays <= 0 {
+		return
+	}
+	ext := filepath.Ext(w.conf.Path)
+	base := strings.TrimSuffix(w.conf.Path, ext)
+	files, err := filepath.Glob(base + "-*" + ext)
+	if err != nil {
+		return
+	}
+	var backups []string
+	for _, f := range files {
+		ts := strings.TrimSuffix(strings.TrimPrefix(f, base+"-"), ext)
+		if _, err := time.Parse(backupTimeFormat, ts); err == nil {
+			backups = append(backups, f)
+		}
+	}
+	// Newest first; the timestamp format sorts lexically.
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	cutoff := time.Now().Add(-time.Duration(w.conf.MaxAgeDays) * 24 * time.Hour)
+	for i, f := range backups {
+		if w.conf.MaxBackups > 0 && i >= w.conf.MaxBackups {
+			os.Remove(f)
+			continue
+		}
+		if w.conf.MaxAgeDays > 0 {
+			if info, err := os.Stat(f); err == nil && info.ModTime().Before(cutoff) {
+				os.Remove(f)
+			}
+		}
+	}
+}
+
+func (w *rotateWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.f.Close()
+}
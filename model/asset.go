@@ -8,6 +8,7 @@ import (
 
 	"github.com/motki/core/eveapi"
 	"github.com/motki/core/evedb"
+	"github.com/motki/core/log"
 )
 
 type Asset struct {
@@ -160,7 +161,7 @@ func (m *AssetManager) GetAssetSystem(a *Asset) (*evedb.System, error) {
 }
 
 func (m *AssetManager) getCorporationAssetFromDB(corpID int, itemID int) (*Asset, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +216,7 @@ func (m *AssetManager) getCorporationAssetFromDB(corpID int, itemID int) (*Asset
 }
 
 func (m *AssetManager) getCorporationAssetsFromDB(corpID int) ([]*Asset, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -265,6 +266,7 @@ func (m *AssetManager) getCorporationAssetsFromDB(corpID int) ([]*Asset, error)
 }
 
 func (m *AssetManager) getCorporationAssetsFromAPI(ctx context.Context, corpID int) ([]*Asset, error) {
+	log.FromContext(ctx).Debugf("model: fetching assets for corporation %d from api", corpID)
 	bps, err := m.eveapi.GetCorporationAssets(ctx, corpID)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/motki/core/eveapi"
+	"github.com/motki/core/log"
 )
 
 type BlueprintKind string
@@ -72,7 +73,7 @@ func (m *BlueprintManager) GetCorporationBlueprints(ctx context.Context, corpID
 }
 
 func (m *BlueprintManager) getCorporationBlueprintsFromDB(corpID int) ([]*Blueprint, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +122,7 @@ func (m *BlueprintManager) getCorporationBlueprintsFromDB(corpID int) ([]*Bluepr
 }
 
 func (m *BlueprintManager) getCorporationBlueprintsFromAPI(ctx context.Context, corpID int) ([]*Blueprint, error) {
+	log.FromContext(ctx).Debugf("model: fetching blueprints for corporation %d from api", corpID)
 	bps, err := m.eveapi.GetCorporationBlueprints(ctx, corpID)
 	if err != nil {
 		return nil, err
@@ -39,7 +39,7 @@ func (m *CharacterManager) GetCharacter(characterID int) (*Character, error) {
 }
 
 func (m *CharacterManager) getCharacterFromDB(characterID int) (*Character, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
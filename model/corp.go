@@ -49,7 +49,7 @@ func (m *CorpManager) GetCorporation(corporationID int) (*Corporation, error) {
 }
 
 func (m *CorpManager) getCorporationFromDB(corporationID int) (*Corporation, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +182,7 @@ func (m *CorpManager) FetchCorporationDetail(ctx context.Context) (*CorporationD
 }
 
 func (m *CorpManager) getCorporationDetailFromDB(corporationID int) (*CorporationDetail, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
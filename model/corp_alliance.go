@@ -26,7 +26,7 @@ func (m *CorpManager) GetAlliance(allianceID int) (*Alliance, error) {
 }
 
 func (m *CorpManager) getAllianceFromDB(allianceID int) (*Alliance, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -149,5 +149,5 @@ func (m *CorpManager) authContext(ctx context.Context, corpID int) (context.Cont
 	if err != nil {
 		return nil, err
 	}
-	return a.Context(), nil
+	return a.WithContext(ctx), nil
 }
@@ -0,0 +1,4 @@
+package model
+
+// MergeReprocessItems exposes mergeReprocessItems to tests.
+var MergeReprocessItems = mergeReprocessItems
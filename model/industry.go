@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/motki/core/eveapi"
+	"github.com/motki/core/log"
 	"github.com/shopspring/decimal"
 )
 
@@ -58,7 +59,7 @@ func (m *IndustryManager) GetCorporationIndustryJobs(ctx context.Context, corpID
 }
 
 func (m *IndustryManager) getCorporationIndustryJobsFromDB(corpID int) ([]*IndustryJob, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +132,7 @@ func (m *IndustryManager) getCorporationIndustryJobsFromDB(corpID int) ([]*Indus
 }
 
 func (m *IndustryManager) getCorporationIndustryJobsFromAPI(ctx context.Context, corpID int) ([]*IndustryJob, error) {
+	log.FromContext(ctx).Debugf("model: fetching industry jobs for corporation %d from api", corpID)
 	jobs, err := m.eveapi.GetCorporationIndustryJobs(ctx, corpID)
 	if err != nil {
 		return nil, err
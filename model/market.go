@@ -103,7 +103,7 @@ func (m *MarketManager) GetMarketStatSystem(systemID int, typeID int, typeIDs ..
 }
 
 func (m *MarketManager) getMarketStatFromDB(regionID, systemID int, typeIDs ...int) ([]*MarketStat, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +270,7 @@ func (m *MarketManager) GetMarketPrices(typeID int, typeIDs ...int) ([]*MarketPr
 }
 
 func (m *MarketManager) getMarketPricesFromDB(typeIDs ...int) ([]*MarketPrice, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
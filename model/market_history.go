@@ -0,0 +1,300 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/log"
+)
+
+const (
+	// DefaultHistoryDays is the number of days of history returned when no
+	// number of days is given.
+	DefaultHistoryDays = 30
+
+	// DefaultHistoryWindow is the number of days in a moving average when no
+	// window is given.
+	DefaultHistoryWindow = 7
+)
+
+// MarketHistory is a summary of a single day of trading for a type in a region.
+type MarketHistory struct {
+	RegionID int             `json:"region_id"`
+	TypeID   int             `json:"type_id"`
+	Date     time.Time       `json:"date"`
+	Volume   int             `json:"volume"`
+	Avg      decimal.Decimal `json:"avg"`
+	Max      decimal.Decimal `json:"max"`
+	Min      decimal.Decimal `json:"min"`
+}
+
+// MarketTrend describes the movement of a type's price and volume over time.
+type MarketTrend struct {
+	RegionID int `json:"region_id"`
+	TypeID   int `json:"type_id"`
+	// Number of days in each moving average.
+	Window int `json:"window"`
+
+	// Daily history, oldest first.
+	History []*MarketHistory `json:"history"`
+	// Moving average of the average price over the window ending on each
+	// day in History. Days before a full window is available average
+	// whatever days are available.
+	MovingAvg []decimal.Decimal `json:"moving_avg"`
+	// Moving average of the daily volume, computed like MovingAvg.
+	MovingVolume []int `json:"moving_volume"`
+
+	// Relative change in average daily volume between the last window and
+	// the window before it, such as 0.25 for a 25% increase.
+	VolumeTrend float64 `json:"volume_trend"`
+	// Standard deviation of the daily change in average price, as a
+	// fraction of the price.
+	Volatility float64 `json:"volatility"`
+}
+
+// NewMarketTrend computes a MarketTrend from the given history, which must be
+// ordered oldest first.
+//
+// If window is zero, DefaultHistoryWindow is used.
+func NewMarketTrend(history []*MarketHistory, window int) *MarketTrend {
+	if window <= 0 {
+		window = DefaultHistoryWindow
+	}
+	t := &MarketTrend{Window: window, History: history}
+	if len(history) == 0 {
+		return t
+	}
+	t.RegionID = history[0].RegionID
+	t.TypeID = history[0].TypeID
+
+	var priceSum decimal.Decimal
+	var volSum int
+	for i, h := range history {
+		priceSum = priceSum.Add(h.Avg)
+		volSum += h.Volume
+		if i >= window {
+			priceSum = priceSum.Sub(history[i-window].Avg)
+			volSum -= history[i-window].Volume
+		}
+		n := i + 1
+		if n > window {
+			n = window
+		}
+		t.MovingAvg = append(t.MovingAvg, priceSum.Div(decimal.New(int64(n), 0)))
+		t.MovingVolume = append(t.MovingVolume, volSum/n)
+	}
+
+	if len(history) >= 2*window {
+		last := t.MovingVolume[len(history)-1]
+		prev := t.MovingVolume[len(history)-1-window]
+		if prev > 0 {
+			t.VolumeTrend = float64(last-prev) / float64(prev)
+		}
+	}
+
+	var returns []float64
+	for i := 1; i < len(history); i++ {
+		prev, _ := history[i-1].Avg.Float64()
+		cur, _ := history[i].Avg.Float64()
+		if prev > 0 && cur > 0 {
+			returns = append(returns, math.Log(cur/prev))
+		}
+	}
+	if len(returns) > 1 {
+		var mean float64
+		for _, r := range returns {
+			mean += r
+		}
+		mean /= float64(len(returns))
+		var variance float64
+		for _, r := range returns {
+			variance += (r - mean) * (r - mean)
+		}
+		t.Volatility = math.Sqrt(variance / float64(len(returns)-1))
+	}
+	return t
+}
+
+// GetMarketHistory returns the daily history for the given type in the given
+// region over the last number of days, oldest first.
+//
+// If days is zero, DefaultHistoryDays is used. The last day is yesterday in
+// UTC, the most recent day published by the API. Any days missing from the
+// stored history are fetched from the API first.
+func (m *MarketManager) GetMarketHistory(ctx context.Context, regionID, typeID, days int) ([]*MarketHistory, error) {
+	if days <= 0 {
+		days = DefaultHistoryDays
+	}
+	yesterday := marketHistoryYesterday()
+	updated, err := m.updateMarketHistory(ctx, regionID, typeID, yesterday)
+	if err != nil {
+		return nil, err
+	}
+	// Freshly written history may not have reached the replicas yet, so it
+	// is read back from the primary.
+	var c *pgx.Conn
+	if updated {
+		c, err = m.pool.Open()
+	} else {
+		c, err = m.pool.OpenRead()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer m.pool.Release(c)
+	return getMarketHistoryFromDB(c, regionID, typeID, yesterday, days)
+}
+
+// GetMarketTrend returns the history for the given type in the given region
+// over the last number of days, along with moving averages of the given
+// window, the volume trend and volatility.
+func (m *MarketManager) GetMarketTrend(ctx context.Context, regionID, typeID, days, window int) (*MarketTrend, error) {
+	history, err := m.GetMarketHistory(ctx, regionID, typeID, days)
+	if err != nil {
+		return nil, err
+	}
+	t := NewMarketTrend(history, window)
+	t.RegionID = regionID
+	t.TypeID = typeID
+	return t, nil
+}
+
+// marketHistoryYesterday returns the start of yesterday in UTC.
+func marketHistoryYesterday() time.Time {
+	return time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+}
+
+// getMarketHistoryFromDB returns the stored history for the number of days
+// ending on the given last day.
+func getMarketHistoryFromDB(c *pgx.Conn, regionID, typeID int, last time.Time, days int) ([]*MarketHistory, error) {
+	rs, err := c.Query(
+		`SELECT
+			  h.region_id
+			, h.type_id
+			, h.date
+			, h.volume
+			, h.average
+			, h.highest
+			, h.lowest
+			FROM app.market_history h
+			WHERE h.region_id = $1
+			  AND h.type_id = $2
+			  AND h.date > ($3::DATE - $4::INTEGER)
+			  AND h.date <= $3::DATE
+			ORDER BY h.date`, regionID, typeID, last, days)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var res []*MarketHistory
+	for rs.Next() {
+		r := &MarketHistory{}
+		err := rs.Scan(
+			&r.RegionID,
+			&r.TypeID,
+			&r.Date,
+			&r.Volume,
+			&r.Avg,
+			&r.Max,
+			&r.Min,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, rs.Err()
+}
+
+// updateMarketHistory fetches the history for the given type and region if
+// the stored history does not include yesterday.
+//
+// Only days missing from the stored history are written. ESI publishes
+// history once per day for the previous day, so the history is fetched at
+// most once per hour, even if the API returns no history for the type.
+//
+// The stored history is checked on the primary rather than a replica, so
+// history written moments ago is not fetched again. The returned bool is true
+// if new history was written.
+func (m *MarketManager) updateMarketHistory(ctx context.Context, regionID, typeID int, yesterday time.Time) (bool, error) {
+	c, err := m.pool.Open()
+	if err != nil {
+		return false, err
+	}
+	var latest pgx.NullTime
+	var recent bool
+	err = c.QueryRow(
+		`SELECT
+			  (SELECT MAX(h.date)
+			    FROM app.market_history h
+			    WHERE h.region_id = $1
+			      AND h.type_id = $2)
+			, EXISTS(
+			    SELECT 1
+			      FROM app.market_history_fetches f
+			      WHERE f.region_id = $1
+			        AND f.type_id = $2
+			        AND f.fetched_at >= (NOW() - INTERVAL '1 hour'))`,
+		regionID, typeID).Scan(&latest, &recent)
+	m.pool.Release(c)
+	if err != nil {
+		return false, err
+	}
+	if latest.Valid && !latest.Time.Before(yesterday) {
+		return false, nil
+	}
+	if recent {
+		return false, nil
+	}
+	log.FromContext(ctx).Debugf("model: fetching market history for type %d in region %d from api", typeID, regionID)
+	stats, err := m.eveapi.GetMarketHistoryRegionTypeID(ctx, regionID, typeID)
+	if err != nil {
+		return false, err
+	}
+	var rows [][]interface{}
+	for _, s := range stats {
+		rows = append(rows, []interface{}{regionID, typeID, s.Timestamp, s.Volume, s.Avg, s.Max, s.Min})
+	}
+	var n int64
+	err = m.pool.WithTx(ctx, func(tx *pgx.Tx) error {
+		_, err := tx.Exec(
+			`INSERT INTO app.market_history_fetches (region_id, type_id, fetched_at)
+				VALUES($1, $2, NOW())
+				ON CONFLICT ON CONSTRAINT "market_history_fetches_pkey" DO
+				UPDATE SET fetched_at = EXCLUDED.fetched_at`,
+			regionID, typeID)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+		// The history is copied into a temporary table so that only the
+		// missing days are inserted in a single statement.
+		_, err = tx.Exec(
+			`CREATE TEMPORARY TABLE market_history_import
+				(LIKE app.market_history INCLUDING DEFAULTS)
+				ON COMMIT DROP`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CopyFrom(
+			pgx.Identifier{"market_history_import"},
+			[]string{"region_id", "type_id", "date", "volume", "average", "highest", "lowest"},
+			pgx.CopyFromRows(rows))
+		if err != nil {
+			return err
+		}
+		tag, err := tx.Exec(
+			`INSERT INTO app.market_history
+				SELECT * FROM market_history_import
+				ON CONFLICT ON CONSTRAINT "market_history_pkey" DO NOTHING`)
+		n = tag.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,137 @@
+package model_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/antihax/goesi/esi"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/eveapi/esitest"
+	"github.com/motki/core/model"
+)
+
+func testHistory(prices []float64, volumes []int) []*model.MarketHistory {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	var res []*model.MarketHistory
+	for i, p := range prices {
+		res = append(res, &model.MarketHistory{
+			RegionID: 10000002,
+			TypeID:   34,
+			Date:     start.AddDate(0, 0, i),
+			Volume:   volumes[i],
+			Avg:      decimal.NewFromFloat(p),
+		})
+	}
+	return res
+}
+
+// TestNewMarketTrend tests the moving averages, volume trend and volatility
+// computed from a short history.
+func TestNewMarketTrend(t *testing.T) {
+	h := testHistory([]float64{10, 20, 30, 40}, []int{100, 100, 200, 200})
+	trend := model.NewMarketTrend(h, 2)
+	if trend.RegionID != 10000002 || trend.TypeID != 34 {
+		t.Errorf("expected region and type from history, got %d and %d", trend.RegionID, trend.TypeID)
+	}
+	expected := []float64{10, 15, 25, 35}
+	for i, e := range expected {
+		if !trend.MovingAvg[i].Equal(decimal.NewFromFloat(e)) {
+			t.Errorf("expected moving average %v on day %d, got %s", e, i, trend.MovingAvg[i])
+		}
+	}
+	if trend.MovingVolume[3] != 200 {
+		t.Errorf("expected moving volume 200 on the last day, got %d", trend.MovingVolume[3])
+	}
+	if trend.VolumeTrend != 1 {
+		t.Errorf("expected volume to have doubled, got trend %v", trend.VolumeTrend)
+	}
+	if trend.Volatility <= 0 {
+		t.Errorf("expected positive volatility, got %v", trend.Volatility)
+	}
+
+	steady := model.NewMarketTrend(testHistory([]float64{10, 11, 12.1, 13.31}, []int{1, 1, 1, 1}), 0)
+	if steady.Window != model.DefaultHistoryWindow {
+		t.Errorf("expected default window, got %d", steady.Window)
+	}
+	if math.Abs(steady.Volatility) > 1e-9 {
+		t.Errorf("expected no volatility for a constant rate of change, got %v", steady.Volatility)
+	}
+	if steady.VolumeTrend != 0 {
+		t.Errorf("expected no volume trend without two full windows, got %v", steady.VolumeTrend)
+	}
+}
+
+// TestGetMarketHistory tests that history is fetched once, ends yesterday,
+// and that only missing days are written when it is fetched again.
+func TestGetMarketHistory(t *testing.T) {
+	srv := esitest.NewServer()
+	defer srv.Close()
+	// Every API call reaches the server, so requests count fetches.
+	srv.Expires = -1
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	var history []esi.GetMarketsRegionIdHistory200Ok
+	for i := 0; i <= 40; i++ {
+		history = append(history, esi.GetMarketsRegionIdHistory200Ok{
+			Date:    today.AddDate(0, 0, -i).Format("2006-01-02"),
+			Volume:  100,
+			Average: 5,
+			Highest: 6,
+			Lowest:  4,
+		})
+	}
+	srv.SetMarketHistory(10000002, history)
+	srv.SetMarketHistory(10000043, []esi.GetMarketsRegionIdHistory200Ok{})
+	m, p := newTestManager(t, srv)
+	defer p.Close()
+	dbtest.Truncate(t, p, "app.market_history", "app.market_history_fetches")
+	ctx := context.Background()
+
+	expect := func(requests int) {
+		h, err := m.GetMarketHistory(ctx, 10000002, 34, 30)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(h) != 30 {
+			t.Fatalf("expected 30 days of history, got %d", len(h))
+		}
+		if first, last := h[0].Date.Format("2006-01-02"), h[29].Date.Format("2006-01-02"); first != today.AddDate(0, 0, -30).Format("2006-01-02") || last != today.AddDate(0, 0, -1).Format("2006-01-02") {
+			t.Errorf("expected history from 30 days ago until yesterday, got %s to %s", first, last)
+		}
+		if n := srv.Requests("/markets/10000002/history/"); n != requests {
+			t.Errorf("expected %d requests, got %d", requests, n)
+		}
+	}
+	expect(1)
+	expect(1)
+
+	// Remove the latest days along with an older one, and allow the history
+	// to be fetched again.
+	exec(t, p, `DELETE FROM app.market_history WHERE date IN ($1::DATE, $1::DATE - 1, $1::DATE - 10)`, today)
+	exec(t, p, `UPDATE app.market_history SET volume = 1 WHERE date = $1::DATE - 2`, today)
+	exec(t, p, `UPDATE app.market_history_fetches SET fetched_at = NOW() - INTERVAL '2 hours'`)
+	expect(2)
+	h, err := m.GetMarketHistory(ctx, 10000002, 34, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(h) != 2 || h[0].Volume != 1 || h[1].Volume != 100 {
+		t.Errorf("expected only the missing days to be written, got %+v", h)
+	}
+
+	for i := 0; i < 2; i++ {
+		h, err := m.GetMarketHistory(ctx, 10000043, 34, 30)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if len(h) != 0 {
+			t.Errorf("expected no history, got %d days", len(h))
+		}
+	}
+	if n := srv.Requests("/markets/10000043/history/"); n != 1 {
+		t.Errorf("expected empty history to be fetched once, got %d requests", n)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"golang.org/x/net/context"
+
+	"github.com/motki/core/log"
 )
 
 // One of: open, expired, cancelled
@@ -93,7 +95,7 @@ func (m *MarketManager) GetCorporationOrders(ctx context.Context, corpID int) (o
 }
 
 func (m *MarketManager) getCorporationOrdersFromDB(corporationID int) ([]*MarketOrder, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +153,7 @@ func (m *MarketManager) getCorporationOrdersFromDB(corporationID int) ([]*Market
 }
 
 func (m *MarketManager) getCorporationOrdersFromAPI(ctx context.Context, corpID int) ([]*MarketOrder, error) {
+	log.FromContext(ctx).Debugf("model: fetching market orders for corporation %d from api", corpID)
 	orders, err := m.eveapi.GetCorporationOrders(ctx, corpID)
 	if err != nil {
 		return nil, err
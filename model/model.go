@@ -16,6 +16,10 @@
 package model // import "github.com/motki/core/model"
 
 import (
+	"time"
+
+	"golang.org/x/net/context"
+
 	"github.com/motki/core/db"
 	"github.com/motki/core/eveapi"
 	"github.com/motki/core/evedb"
@@ -95,6 +99,10 @@ func (m *Manager) UpdateCorporationDataFunc(logger log.Logger) func() error {
 			return nil
 		}
 		for _, corpID := range corps {
+			if l := m.CorpManager.eveapi.Limits(); l.Paused {
+				logger.Warnf("esi error limit reached, not updating corp data until %s", l.ErrorsReset.Format(time.RFC3339))
+				return nil
+			}
 			logger.Debugf("updating data for corp %d", corpID)
 			a, err := m.GetCorporationAuthorization(corpID)
 			if err != nil {
@@ -102,7 +110,7 @@ func (m *Manager) UpdateCorporationDataFunc(logger log.Logger) func() error {
 				continue
 			}
 
-			ctx := a.Context()
+			ctx := a.WithContext(log.NewContext(context.Background(), logger.WithField(log.FieldCorporationID, corpID)))
 
 			if _, err := m.FetchCorporationDetail(ctx); err != nil {
 				logger.Errorf("error fetching corp details: %s", err.Error())
@@ -0,0 +1,122 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/antihax/goesi/esi"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/eveapi"
+	"github.com/motki/core/eveapi/esitest"
+	"github.com/motki/core/evedb"
+	"github.com/motki/core/evemarketer"
+	"github.com/motki/core/log"
+	"github.com/motki/core/model"
+)
+
+// newTestManager returns a Manager using the test database and the given fake
+// ESI server.
+//
+// The test is skipped if no test database is configured. The caller should
+// Close the returned pool when finished.
+func newTestManager(t *testing.T, srv *esitest.Server) (*model.Manager, *db.ConnPool) {
+	p := dbtest.New(t)
+	api := eveapi.New(eveapi.Config{ServerURL: srv.URL, RequestsPerSecond: -1}, log.New(log.Config{Level: "fatal"}))
+	return model.NewManager(p, evedb.New(p), api, evemarketer.New()), p
+}
+
+// exec executes the given statement on the test database, failing the test on
+// error.
+func exec(t *testing.T, p *db.ConnPool, sql string, args ...interface{}) {
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	if _, err = c.Exec(sql, args...); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+}
+
+// queryInt returns the integer result of the given query, failing the test on
+// error.
+func queryInt(t *testing.T, p *db.ConnPool, sql string, args ...interface{}) int {
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	var n int
+	if err = c.QueryRow(sql, args...).Scan(&n); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return n
+}
+
+// TestUpdateCorporationData tests that the assets, blueprints and structures
+// of an opted-in corporation are fetched from ESI and stored.
+func TestUpdateCorporationData(t *testing.T) {
+	const corpID, charID = 98000001, 90000001
+	srv := esitest.NewServer()
+	defer srv.Close()
+	srv.SetCharacter(charID, esi.GetCharactersCharacterIdOk{Name: "Test Director", CorporationId: corpID})
+	srv.SetCorporationAssets(corpID, []esi.GetCorporationsCorporationIdAssets200Ok{
+		{ItemId: 1, TypeId: 34, Quantity: 100, LocationId: 60003760, LocationFlag: "CorpSAG1", LocationType: "station"},
+		{ItemId: 2, TypeId: 35, Quantity: 200, LocationId: 60003760, LocationFlag: "CorpSAG1", LocationType: "station"},
+	})
+	srv.SetCorporationBlueprints(corpID, []esi.GetCorporationsCorporationIdBlueprints200Ok{
+		{ItemId: 3, TypeId: 681, Quantity: -1, Runs: -1, MaterialEfficiency: 10, TimeEfficiency: 20, LocationId: 60003760, LocationFlag: "CorpSAG2"},
+		{ItemId: 4, TypeId: 681, Quantity: -2, Runs: 5, LocationId: 60003760, LocationFlag: "CorpSAG2"},
+	})
+	srv.SetCorporationStructures(corpID, []esi.GetCorporationsCorporationIdStructures200Ok{
+		{StructureId: 1000000000001, SystemId: 30000142, TypeId: 35832, ProfileId: 1, State: "shield_vulnerable"},
+	})
+	srv.SetStructure(1000000000001, esi.GetUniverseStructuresStructureIdOk{Name: "Jita - Test Structure", SolarSystemId: 30000142, TypeId: 35832})
+	m, p := newTestManager(t, srv)
+	defer p.Close()
+	dbtest.Truncate(t, p,
+		"app.assets", "app.blueprints", "app.structures", "app.characters",
+		"app.corporation_settings", "app.user_authorizations", "app.users CASCADE")
+
+	// The director logs in through SSO and opts the corporation in.
+	u, err := m.NewUser("director", "director@example.com", "password")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	api := eveapi.New(eveapi.Config{ServerURL: srv.URL, RequestsPerSecond: -1}, log.New(log.Config{Level: "fatal"}))
+	tok, err := api.TokenExchange(srv.Login(esitest.Login{CharacterID: charID, CharacterName: "Test Director"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err = m.SaveAuthorization(u, model.RoleDirector, charID, tok); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	err = m.SaveCorporationConfig(corpID, &model.CorporationConfig{OptIn: true, OptInBy: u.UserID, OptInDate: time.Now(), CreatedBy: u.UserID})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err = m.UpdateCorporationDataFunc(log.New(log.Config{Level: "fatal"}))(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM app.assets WHERE corporation_id = $1 AND valid = TRUE`, corpID); n != 2 {
+		t.Errorf("expected 2 assets, got %d", n)
+	}
+	if n := queryInt(t, p, `SELECT quantity FROM app.assets WHERE corporation_id = $1 AND item_id = 2`, corpID); n != 200 {
+		t.Errorf("expected 200 units of asset 2, got %d", n)
+	}
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM app.blueprints WHERE corporation_id = $1 AND kind = 'bpo' AND material_efficiency = 10`, corpID); n != 1 {
+		t.Errorf("expected 1 original blueprint, got %d", n)
+	}
+	if n := queryInt(t, p, `SELECT COUNT(*) FROM app.blueprints WHERE corporation_id = $1 AND kind = 'bpc' AND quantity = 1 AND runs = 5`, corpID); n != 1 {
+		t.Errorf("expected 1 blueprint copy, got %d", n)
+	}
+	n := queryInt(t, p, `SELECT COUNT(*) FROM app.structures
+		WHERE corporation_id = $1 AND name = 'Jita - Test Structure' AND system_id = 30000142 AND curr_state = 'shield_vulnerable'`, corpID)
+	if n != 1 {
+		t.Errorf("expected structure to be stored with its name, got %d", n)
+	}
+}
@@ -276,27 +276,13 @@ func (m *ProductManager) saveProductWithTx(tx *pgx.Tx, product *Product) error {
 //
 // This function automatically handles both inserting and updating.
 func (m *ProductManager) SaveProduct(product *Product) error {
-	if _, err := m.corp.authContext(context.Background(), product.CorporationID); err != nil {
-		return err
-	}
-	c, err := m.pool.Open()
-	if err != nil {
-		return err
-	}
-	defer m.pool.Release(c)
-	tx, err := c.Begin()
-	if err != nil {
-		return err
-	}
-	err = m.saveProductWithTx(tx, product)
-	if err != nil {
-		errTx := tx.Rollback()
-		if errTx != nil {
-			err = errors.Wrapf(err, "unable to rollback db transaction: %s", errTx.Error())
-		}
+	ctx := context.Background()
+	if _, err := m.corp.authContext(ctx, product.CorporationID); err != nil {
 		return err
 	}
-	return errors.Wrap(tx.Commit(), "couldn't commit db transaction")
+	return m.pool.WithTx(ctx, func(tx *pgx.Tx) error {
+		return m.saveProductWithTx(tx, product)
+	})
 }
 
 // GetAllProducts returns all production chains associated with the given corporation.
@@ -0,0 +1,227 @@
+package model
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jackc/pgx"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/motki/core/evedb"
+)
+
+// categoryAsteroid is the inventory category containing ore and ice.
+const categoryAsteroid = 25
+
+// Common base reprocessing yields.
+const (
+	// BaseYieldStation is the yield of an NPC station.
+	BaseYieldStation = 0.5
+	// BaseYieldStructure is the yield of a structure without a reprocessing rig.
+	BaseYieldStructure = 0.5
+	// BaseYieldStructureT1Rig is the yield of a structure with a tech 1 rig.
+	BaseYieldStructureT1Rig = 0.51
+	// BaseYieldStructureT2Rig is the yield of a structure with a tech 2 rig.
+	BaseYieldStructureT2Rig = 0.53
+)
+
+// ReprocessSkills are the skill levels of a character that affect their
+// reprocessing yield.
+type ReprocessSkills struct {
+	// Reprocessing gives a 3% bonus per level to ore and ice.
+	Reprocessing int `json:"reprocessing"`
+	// ReprocessingEfficiency gives a 2% bonus per level to ore and ice.
+	ReprocessingEfficiency int `json:"reprocessing_efficiency"`
+	// OreProcessing is the level of the processing skill for the specific
+	// ore or ice being reprocessed, giving a 2% bonus per level.
+	OreProcessing int `json:"ore_processing"`
+	// ScrapmetalProcessing gives a 2% bonus per level to everything other
+	// than ore and ice.
+	ScrapmetalProcessing int `json:"scrapmetal_processing"`
+}
+
+// ReprocessParams describe where and by whom items are reprocessed.
+type ReprocessParams struct {
+	// Base yield of the station or structure, between 0 and 1.
+	BaseYield float64         `json:"base_yield"`
+	Skills    ReprocessSkills `json:"skills"`
+	// Bonus from a reprocessing implant, such as 0.04 for 4%.
+	Implant float64 `json:"implant"`
+	// Fraction of the resulting materials kept by the station owner.
+	Tax float64 `json:"tax"`
+}
+
+// Yield returns the fraction of materials recovered when reprocessing an item
+// in the given inventory category.
+//
+// Ore and ice benefit from the reprocessing skills and implant, while
+// everything else benefits only from Scrapmetal Processing. Tax is not
+// included.
+func (p ReprocessParams) Yield(categoryID int) float64 {
+	s := p.Skills
+	var y float64
+	if categoryID == categoryAsteroid {
+		y = p.BaseYield *
+			(1 + 0.03*float64(s.Reprocessing)) *
+			(1 + 0.02*float64(s.ReprocessingEfficiency)) *
+			(1 + 0.02*float64(s.OreProcessing)) *
+			(1 + p.Implant)
+	} else {
+		y = p.BaseYield * (1 + 0.02*float64(s.ScrapmetalProcessing))
+	}
+	return math.Min(y, 1)
+}
+
+// Validate returns an error if the parameters are out of range.
+func (p ReprocessParams) Validate() error {
+	if p.BaseYield <= 0 || p.BaseYield > 1 {
+		return errors.Errorf("base yield must be between 0 and 1, got %v", p.BaseYield)
+	}
+	if p.Tax < 0 || p.Tax > 1 {
+		return errors.Errorf("tax must be between 0 and 1, got %v", p.Tax)
+	}
+	if p.Implant < 0 {
+		return errors.Errorf("implant bonus cannot be negative, got %v", p.Implant)
+	}
+	s := p.Skills
+	for _, l := range []int{s.Reprocessing, s.ReprocessingEfficiency, s.OreProcessing, s.ScrapmetalProcessing} {
+		if l < 0 || l > 5 {
+			return errors.Errorf("skill levels must be between 0 and 5, got %d", l)
+		}
+	}
+	return nil
+}
+
+// A ReprocessItem is a type and quantity of an item.
+type ReprocessItem struct {
+	TypeID   int `json:"type_id"`
+	Quantity int `json:"quantity"`
+}
+
+// A ReprocessMaterial is a material produced by reprocessing.
+type ReprocessMaterial struct {
+	TypeID   int `json:"type_id"`
+	Quantity int `json:"quantity"`
+	// Average market price of a single unit.
+	Price decimal.Decimal `json:"price"`
+	// Value of the entire quantity.
+	Value decimal.Decimal `json:"value"`
+}
+
+// A ReprocessResult describes the outcome of reprocessing a list of items.
+type ReprocessResult struct {
+	Materials []*ReprocessMaterial `json:"materials"`
+	// Items left unprocessed because they do not make up a full portion,
+	// cannot be reprocessed, or are not known published types.
+	Leftovers []*ReprocessItem `json:"leftovers"`
+	// Total value of the materials.
+	Value decimal.Decimal `json:"value"`
+}
+
+// ReprocessBatch returns the materials produced by reprocessing quantity units
+// of an item, given the materials in a single portion and the portion size.
+//
+// Materials are rounded down per item type. The number of units left over is
+// also returned.
+func (p ReprocessParams) ReprocessBatch(mats []*evedb.Material, portionSize, quantity, categoryID int) (map[int]int, int) {
+	if portionSize < 1 {
+		portionSize = 1
+	}
+	batches := quantity / portionSize
+	res := make(map[int]int)
+	if batches == 0 || len(mats) == 0 {
+		return res, quantity
+	}
+	y := p.Yield(categoryID) * (1 - p.Tax)
+	for _, m := range mats {
+		// The small epsilon guards against results like 0.9999999 units.
+		n := int(math.Floor(float64(m.Quantity*batches)*y + 1e-9))
+		if n > 0 {
+			res[m.ID] += n
+		}
+	}
+	return res, quantity - batches*portionSize
+}
+
+// mergeReprocessItems returns the total quantity of each type in the given
+// items, in the order each type first appears.
+func mergeReprocessItems(items []*ReprocessItem) ([]*ReprocessItem, error) {
+	var res []*ReprocessItem
+	byType := make(map[int]*ReprocessItem)
+	for _, it := range items {
+		if it.Quantity <= 0 {
+			return nil, errors.Errorf("quantity must be positive, got %d for type %d", it.Quantity, it.TypeID)
+		}
+		if m, ok := byType[it.TypeID]; ok {
+			m.Quantity += it.Quantity
+			continue
+		}
+		m := &ReprocessItem{TypeID: it.TypeID, Quantity: it.Quantity}
+		byType[it.TypeID] = m
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+// Reprocess returns the materials produced by reprocessing the given items,
+// valued at current average market prices.
+//
+// Quantities of the same type are combined before reprocessing, so that
+// partial portions spread across several items are not left over. Unknown
+// types are returned as leftovers.
+func (m *MarketManager) Reprocess(params ReprocessParams, items ...*ReprocessItem) (*ReprocessResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	items, err := mergeReprocessItems(items)
+	if err != nil {
+		return nil, err
+	}
+	res := &ReprocessResult{}
+	totals := make(map[int]int)
+	for _, it := range items {
+		t, err := m.evedb.GetItemTypeDetail(it.TypeID)
+		if err == pgx.ErrNoRows {
+			res.Leftovers = append(res.Leftovers, it)
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get type %d", it.TypeID)
+		}
+		mats, err := m.evedb.GetReprocessingMaterials(it.TypeID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get reprocessing materials for type %d", it.TypeID)
+		}
+		out, left := params.ReprocessBatch(mats, t.PortionSize, it.Quantity, t.CategoryID)
+		for id, n := range out {
+			totals[id] += n
+		}
+		if left > 0 {
+			res.Leftovers = append(res.Leftovers, &ReprocessItem{TypeID: it.TypeID, Quantity: left})
+		}
+	}
+	if len(totals) == 0 {
+		return res, nil
+	}
+	var ids []int
+	for id := range totals {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	prices, err := m.GetMarketPrices(ids[0], ids[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	priceMap := make(map[int]decimal.Decimal)
+	for _, p := range prices {
+		priceMap[p.TypeID] = p.Avg
+	}
+	for _, id := range ids {
+		mat := &ReprocessMaterial{TypeID: id, Quantity: totals[id], Price: priceMap[id]}
+		mat.Value = mat.Price.Mul(decimal.New(int64(mat.Quantity), 0))
+		res.Value = res.Value.Add(mat.Value)
+		res.Materials = append(res.Materials, mat)
+	}
+	return res, nil
+}
@@ -0,0 +1,108 @@
+package model_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/motki/core/evedb"
+	"github.com/motki/core/model"
+)
+
+// TestReprocessParamsYield tests the yield of ore and other items with
+// various skills.
+func TestReprocessParamsYield(t *testing.T) {
+	p := model.ReprocessParams{
+		BaseYield: model.BaseYieldStation,
+		Skills: model.ReprocessSkills{
+			Reprocessing:           5,
+			ReprocessingEfficiency: 5,
+			OreProcessing:          4,
+			ScrapmetalProcessing:   3,
+		},
+		Implant: 0.04,
+	}
+	if y := p.Yield(25); math.Abs(y-0.5*1.15*1.1*1.08*1.04) > 1e-9 {
+		t.Errorf("expected ore yield of %v, got %v", 0.5*1.15*1.1*1.08*1.04, y)
+	}
+	if y := p.Yield(7); math.Abs(y-0.53) > 1e-9 {
+		t.Errorf("expected scrapmetal yield of 0.53, got %v", y)
+	}
+	p.BaseYield = 1
+	if y := p.Yield(25); y != 1 {
+		t.Errorf("expected yield to be capped at 1, got %v", y)
+	}
+}
+
+// TestReprocessParamsReprocessBatch tests portions, rounding, tax and
+// leftover units.
+func TestReprocessParamsReprocessBatch(t *testing.T) {
+	mats := []*evedb.Material{
+		{ItemType: &evedb.ItemType{ID: 34}, Quantity: 415},
+		{ItemType: &evedb.ItemType{ID: 35}, Quantity: 3},
+	}
+	p := model.ReprocessParams{BaseYield: 0.5, Tax: 0.1}
+	out, left := p.ReprocessBatch(mats, 100, 250, 25)
+	if left != 50 {
+		t.Errorf("expected 50 units left over, got %d", left)
+	}
+	// 2 batches * 415 * 0.5 * 0.9 = 373.5
+	if out[34] != 373 {
+		t.Errorf("expected 373 Tritanium, got %d", out[34])
+	}
+	// 2 batches * 3 * 0.5 * 0.9 = 2.7
+	if out[35] != 2 {
+		t.Errorf("expected 2 Pyerite, got %d", out[35])
+	}
+
+	out, left = p.ReprocessBatch(mats, 100, 99, 25)
+	if left != 99 || len(out) != 0 {
+		t.Errorf("expected nothing reprocessed from a partial portion, got %v and %d left", out, left)
+	}
+	out, left = p.ReprocessBatch(nil, 1, 10, 7)
+	if left != 10 || len(out) != 0 {
+		t.Errorf("expected nothing reprocessed without materials, got %v and %d left", out, left)
+	}
+}
+
+func TestReprocessParamsValidate(t *testing.T) {
+	tests := []model.ReprocessParams{
+		{BaseYield: 0},
+		{BaseYield: 1.5},
+		{BaseYield: 0.5, Tax: -0.1},
+		{BaseYield: 0.5, Implant: -1},
+		{BaseYield: 0.5, Skills: model.ReprocessSkills{Reprocessing: 6}},
+	}
+	for _, p := range tests {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected error for %+v", p)
+		}
+	}
+	if err := (model.ReprocessParams{BaseYield: 0.5, Tax: 0.05}).Validate(); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+}
+
+// TestMergeReprocessItems tests that quantities of the same type are combined
+// in the order each type first appears.
+func TestMergeReprocessItems(t *testing.T) {
+	items, err := model.MergeReprocessItems([]*model.ReprocessItem{
+		{TypeID: 1230, Quantity: 60},
+		{TypeID: 34, Quantity: 10},
+		{TypeID: 1230, Quantity: 40},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expected := []model.ReprocessItem{{TypeID: 1230, Quantity: 100}, {TypeID: 34, Quantity: 10}}
+	if len(items) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(items))
+	}
+	for i, e := range expected {
+		if *items[i] != e {
+			t.Errorf("expected %+v, got %+v", e, *items[i])
+		}
+	}
+	if _, err = model.MergeReprocessItems([]*model.ReprocessItem{{TypeID: 34, Quantity: 0}}); err == nil {
+		t.Errorf("expected error for zero quantity")
+	}
+}
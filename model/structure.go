@@ -13,6 +13,7 @@ import (
 	"database/sql/driver"
 
 	"github.com/motki/core/eveapi"
+	"github.com/motki/core/log"
 )
 
 // A Structure is a player-owned citadel.
@@ -116,7 +117,7 @@ func (m *StructureManager) GetStructure(ctx context.Context, structureID int) (*
 }
 
 func (m *StructureManager) getCorporationStructuresFromDB(corpID int) ([]*CorporationStructure, error) {
-	c, err := m.pool.Open()
+	c, err := m.pool.OpenRead()
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +183,7 @@ func (m *StructureManager) getCorporationStructuresFromDB(corpID int) ([]*Corpor
 }
 
 func (m *StructureManager) getCorporationStructuresFromAPI(ctx context.Context, corpID int) ([]*CorporationStructure, error) {
+	log.FromContext(ctx).Debugf("model: fetching structures for corporation %d from api", corpID)
 	strucs, err := m.eveapi.GetCorporationStructures(ctx, corpID)
 	if err != nil {
 		return nil, err
@@ -270,6 +272,7 @@ func (m *StructureManager) apiCorporationStructuresToDB(corpID int, strucs []*ev
 }
 
 func (m *StructureManager) getStructureFromAPI(ctx context.Context, structureID int) (*Structure, error) {
+	log.FromContext(ctx).Debugf("model: fetching structure %d from api", structureID)
 	s, err := m.eveapi.GetStructure(ctx, int64(structureID))
 	if err != nil {
 		return nil, err
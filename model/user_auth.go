@@ -210,5 +210,12 @@ type Authorization struct {
 }
 
 func (a *Authorization) Context() context.Context {
-	return newAuthContext(a)
+	return newAuthContext(context.Background(), a)
+}
+
+// WithContext returns a copy of ctx that carries the authorization.
+//
+// Values in ctx, such as a request-scoped Logger, are preserved.
+func (a *Authorization) WithContext(ctx context.Context) context.Context {
+	return newAuthContext(ctx, a)
 }
@@ -36,9 +36,9 @@ func (ctx authContextImpl) Role() Role {
 	return ctx.a.Role
 }
 
-func newAuthContext(a *Authorization) context.Context {
+func newAuthContext(parent context.Context, a *Authorization) context.Context {
 	return authContextImpl{
-		Context: context.WithValue(context.Background(), goesi.ContextOAuth2, a.source),
+		Context: context.WithValue(parent, goesi.ContextOAuth2, a.source),
 		a:       a,
 	}
 }
@@ -59,6 +59,8 @@ type Client interface {
 	// GetAncestry returns information about the given ancestry ID.
 	GetAncestry(ancestryID int) (*evedb.Ancestry, error)
 
+	// GetSDEVersion returns an identifier for the static dump installed on the server.
+	GetSDEVersion() (string, error)
 	// GetRegion returns information about the given region ID.
 	GetRegion(regionID int) (*evedb.Region, error)
 	// GetRegions returns a slice containing information about all regions in the EVE universe.
@@ -67,6 +69,10 @@ type Client interface {
 	GetConstellation(constellationID int) (*evedb.Constellation, error)
 	// GetSystem returns information about the given system ID.
 	GetSystem(systemID int) (*evedb.System, error)
+	// GetRoute returns the route between the origin and destination systems.
+	GetRoute(originID, destinationID int, flag evedb.RouteFlag, avoidIDs ...int) (*evedb.Route, error)
+	// GetJumps returns the number of jumps in the shortest route between two systems.
+	GetJumps(originID, destinationID int) (int, error)
 
 	// GetItemType returns information about the given type ID.
 	GetItemType(typeID int) (*evedb.ItemType, error)
@@ -79,6 +85,16 @@ type Client interface {
 	QueryItemTypeDetails(query string, catIDs ...int) ([]*evedb.ItemTypeDetail, error)
 	// GetMaterialSheet returns manufacturing information about the given type ID.
 	GetMaterialSheet(typeID int) (*evedb.MaterialSheet, error)
+	// GetIndustryBlueprint returns the industry activities that can be performed using the given blueprint type ID.
+	GetIndustryBlueprint(typeID int) (*evedb.Blueprint, error)
+	// GetIndustryBlueprintByProduct returns the blueprint that produces the given type ID using the given activity.
+	GetIndustryBlueprintByProduct(productTypeID int, activity evedb.ActivityType) (*evedb.Blueprint, error)
+	// GetTypeDogma returns the dogma attributes and effects of the given type ID.
+	GetTypeDogma(typeID int) (*evedb.TypeDogma, error)
+	// GetTypeDogmas returns the dogma attributes and effects of each of the given type IDs.
+	GetTypeDogmas(typeIDs ...int) ([]*evedb.TypeDogma, error)
+	// GetAttributeTypes returns information about each of the given dogma attribute IDs.
+	GetAttributeTypes(attributeIDs ...int) ([]*evedb.AttributeType, error)
 
 	// GetInventory returns all inventory items for the current session's corporation.
 	GetInventory() ([]*model.InventoryItem, error)
@@ -92,6 +108,10 @@ type Client interface {
 	GetMarketPrice(typeID int) (*model.MarketPrice, error)
 	// GetMarketPrices returns a slice of market prices for each of the given type IDs.
 	GetMarketPrices(typeID int, typeIDs ...int) ([]*model.MarketPrice, error)
+	// GetMarketHistory returns the daily history and trend for the given type in the given region.
+	GetMarketHistory(regionID, typeID, days, window int) (*model.MarketTrend, error)
+	// Reprocess returns the materials produced by reprocessing the given items and their value.
+	Reprocess(params model.ReprocessParams, items ...*model.ReprocessItem) (*model.ReprocessResult, error)
 
 	// GetCorpBlueprints returns the current session's corporation's blueprints.
 	GetCorpBlueprints() ([]*model.Blueprint, error)
@@ -141,7 +161,15 @@ func New(conf proto.Config, logger log.Logger) (Client, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "app: unable to initialize backend")
 		}
-		cl, err = newRemoteGRPC(conf.ServerAddr, logger, tc)
+		var diskPath string
+		if conf.DiskCache {
+			diskPath, err = conf.DiskCacheFile()
+			if err != nil {
+				return nil, errors.Wrap(err, "app: unable to initialize backend")
+			}
+			logger.Debugf("grpc client: using disk cache at %s", diskPath)
+		}
+		cl, err = newRemoteGRPC(conf.ServerAddr, logger, tc, diskPath)
 		if err != nil {
 			return nil, errors.Wrap(err, "app: unable to initialize backend")
 		}
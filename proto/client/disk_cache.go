@@ -0,0 +1,315 @@
+package client
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// diskCacheMagic identifies a disk cache file and the version of its format.
+const diskCacheMagic = "motki-cache 1\n"
+
+// diskCacheMaxSize is the maximum size of a disk cache file, in bytes.
+//
+// When a write would exceed the maximum, the file is compacted. If it is still
+// too large, its contents are discarded.
+const diskCacheMaxSize = 64 << 20
+
+// errDiskCacheLocked is returned when opening a disk cache that is in use by
+// another process.
+var errDiskCacheLocked = errors.New("disk cache is in use by another process")
+
+// diskCache is a persistent key/value store kept in a single local file.
+//
+// The file begins with a header containing a version stamp for the data it
+// holds, followed by a sequence of records. Each record contains a key and its
+// JSON encoded value. Records are only ever appended; the last record for a key
+// wins. An index of each key's position in the file is kept in memory, the
+// values themselves are read from the file on demand.
+//
+// Superseded records are removed by compacting the file when it is opened and
+// when it reaches diskCacheMaxSize. When the version stamp changes, the entire
+// store is discarded.
+//
+// The file is locked while open, so only one process may use it at a time.
+type diskCache struct {
+	path    string
+	f       *os.File
+	version string
+	index   map[string]diskRecord
+	start   int64 // Offset of the first record.
+	end     int64 // Offset of the end of the last complete record.
+	live    int64 // Total size of the records in the index.
+
+	mu sync.Mutex
+}
+
+// diskRecord is the location of a value in the file.
+type diskRecord struct {
+	off int64
+	len int
+}
+
+// size returns the size of the entire record containing the value, including
+// its key.
+func (r diskRecord) size(key string) int64 {
+	return int64(8 + len(key) + r.len)
+}
+
+// openDiskCache opens or creates the disk cache at the given path.
+//
+// If the existing file is not a valid disk cache, it is discarded. If the file
+// is in use by another process, errDiskCacheLocked is returned.
+func openDiskCache(path string) (*diskCache, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Wrap(err, "unable to create disk cache directory")
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open disk cache")
+	}
+	if err = lockFile(f); err != nil {
+		f.Close()
+		return nil, errDiskCacheLocked
+	}
+	d := &diskCache{path: path, f: f, index: make(map[string]diskRecord)}
+	if err = d.load(); err != nil {
+		if err = d.reset(""); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return d, nil
+	}
+	if d.garbage() > 0 {
+		// The cache remains usable, if larger than necessary, when
+		// compaction fails.
+		d.compact()
+	}
+	return d, nil
+}
+
+// load reads the header and builds the index from the records in the file.
+//
+// A partially written record at the end of the file is discarded.
+func (d *diskCache) load() error {
+	if _, err := d.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(d.f)
+	magic := make([]byte, len(diskCacheMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != diskCacheMagic {
+		return errors.New("invalid disk cache header")
+	}
+	var n uint32
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return err
+	}
+	v := make([]byte, n)
+	if _, err := io.ReadFull(r, v); err != nil {
+		return err
+	}
+	d.version = string(v)
+	d.index = make(map[string]diskRecord)
+	d.start = int64(len(diskCacheMagic) + 4 + len(v))
+	d.end = d.start
+	d.live = 0
+	for {
+		var hdr [2]uint32
+		if err := binary.Read(r, binary.BigEndian, &hdr); err != nil {
+			break
+		}
+		k := make([]byte, hdr[0])
+		if _, err := io.ReadFull(r, k); err != nil {
+			break
+		}
+		if _, err := r.Discard(int(hdr[1])); err != nil {
+			break
+		}
+		off := d.end + 8 + int64(hdr[0])
+		d.setIndex(string(k), diskRecord{off: off, len: int(hdr[1])})
+		d.end = off + int64(hdr[1])
+	}
+	return d.f.Truncate(d.end)
+}
+
+// setIndex records the location of the latest value for the given key.
+func (d *diskCache) setIndex(key string, rec diskRecord) {
+	if old, ok := d.index[key]; ok {
+		d.live -= old.size(key)
+	}
+	d.index[key] = rec
+	d.live += rec.size(key)
+}
+
+// garbage returns the number of bytes used by superseded records.
+func (d *diskCache) garbage() int64 {
+	return d.end - d.start - d.live
+}
+
+// diskCacheHeader returns the header of a disk cache file with the given
+// version.
+func diskCacheHeader(version string) []byte {
+	hdr := make([]byte, 0, len(diskCacheMagic)+4+len(version))
+	hdr = append(hdr, diskCacheMagic...)
+	hdr = append(hdr, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(hdr[len(diskCacheMagic):], uint32(len(version)))
+	return append(hdr, version...)
+}
+
+// diskCacheRecord returns a record containing the given key and value.
+func diskCacheRecord(key string, b []byte) []byte {
+	rec := make([]byte, 8, 8+len(key)+len(b))
+	binary.BigEndian.PutUint32(rec[0:], uint32(len(key)))
+	binary.BigEndian.PutUint32(rec[4:], uint32(len(b)))
+	rec = append(rec, key...)
+	return append(rec, b...)
+}
+
+// reset discards the contents of the store, stamping it with the given version.
+func (d *diskCache) reset(version string) error {
+	if err := d.f.Truncate(0); err != nil {
+		return err
+	}
+	hdr := diskCacheHeader(version)
+	if _, err := d.f.WriteAt(hdr, 0); err != nil {
+		return err
+	}
+	d.version = version
+	d.index = make(map[string]diskRecord)
+	d.start = int64(len(hdr))
+	d.end = d.start
+	d.live = 0
+	return nil
+}
+
+// compact rewrites the file with only the latest record for each key.
+//
+// The records are written to a temporary file that then replaces the original,
+// so an interrupted compaction leaves the original intact. If the original
+// cannot be replaced, the temporary file continues to be used until the cache
+// is closed.
+func (d *diskCache) compact() error {
+	tmp, err := os.OpenFile(d.path+".tmp", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	fail := func(err error) error {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = lockFile(tmp); err != nil {
+		return fail(err)
+	}
+	keys := make([]string, 0, len(d.index))
+	for k := range d.index {
+		keys = append(keys, k)
+	}
+	// Copy records in the order they appear in the original.
+	sort.Slice(keys, func(i, j int) bool { return d.index[keys[i]].off < d.index[keys[j]].off })
+	w := bufio.NewWriter(tmp)
+	hdr := diskCacheHeader(d.version)
+	if _, err = w.Write(hdr); err != nil {
+		return fail(err)
+	}
+	index := make(map[string]diskRecord, len(keys))
+	end := int64(len(hdr))
+	for _, k := range keys {
+		rec := d.index[k]
+		b := make([]byte, rec.len)
+		if _, err = d.f.ReadAt(b, rec.off); err != nil {
+			return fail(err)
+		}
+		if _, err = w.Write(diskCacheRecord(k, b)); err != nil {
+			return fail(err)
+		}
+		index[k] = diskRecord{off: end + 8 + int64(len(k)), len: rec.len}
+		end += rec.size(k)
+	}
+	if err = w.Flush(); err != nil {
+		return fail(err)
+	}
+	if err = tmp.Sync(); err != nil {
+		return fail(err)
+	}
+	// Windows does not allow replacing a file that is open, so the original
+	// is closed first.
+	d.f.Close()
+	d.f = tmp
+	d.index = index
+	d.start = int64(len(hdr))
+	d.end = end
+	return os.Rename(tmp.Name(), d.path)
+}
+
+// setVersion discards the contents of the store if they were written for a
+// different version.
+func (d *diskCache) setVersion(version string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.version == version {
+		return nil
+	}
+	return d.reset(version)
+}
+
+// get decodes the value stored for the given key into dst, returning false if
+// no value exists or it cannot be decoded.
+func (d *diskCache) get(key string, dst interface{}) bool {
+	d.mu.Lock()
+	rec, ok := d.index[key]
+	if !ok {
+		d.mu.Unlock()
+		return false
+	}
+	b := make([]byte, rec.len)
+	_, err := d.f.ReadAt(b, rec.off)
+	d.mu.Unlock()
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, dst) == nil
+}
+
+// put stores the given value for the given key.
+func (d *diskCache) put(key string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	rec := diskCacheRecord(key, b)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.end+int64(len(rec)) > diskCacheMaxSize {
+		if d.garbage() > 0 {
+			if err = d.compact(); err != nil {
+				return err
+			}
+		}
+		if d.end+int64(len(rec)) > diskCacheMaxSize {
+			if err = d.reset(d.version); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err = d.f.WriteAt(rec, d.end); err != nil {
+		return err
+	}
+	d.setIndex(key, diskRecord{off: d.end + 8 + int64(len(key)), len: len(b)})
+	d.end += int64(len(rec))
+	return nil
+}
+
+// Close closes the underlying file, releasing its lock.
+func (d *diskCache) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.f.Close()
+}
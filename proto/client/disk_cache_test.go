@@ -0,0 +1,204 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testValue struct {
+	Name string `json:"name"`
+}
+
+// newTestDiskCache returns a disk cache in a new temporary directory, along
+// with its path and a function that removes the directory.
+func newTestDiskCache(t *testing.T) (*diskCache, string, func()) {
+	dir, err := ioutil.TempDir("", "motki-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "static.cache")
+	d, err := openDiskCache(path)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return d, path, func() { os.RemoveAll(dir) }
+}
+
+// reopen closes the disk cache and opens it again from the same path.
+func reopen(t *testing.T, d *diskCache, path string) *diskCache {
+	if err := d.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	d, err := openDiskCache(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return d
+}
+
+func expectValue(t *testing.T, d *diskCache, key, name string) {
+	var v testValue
+	if !d.get(key, &v) {
+		t.Errorf("expected value for %s", key)
+		return
+	}
+	if v.Name != name {
+		t.Errorf("expected %s for %s, got %s", name, key, v.Name)
+	}
+}
+
+// TestDiskCacheGetPut tests that the latest value for each key is returned,
+// before and after the file is reopened.
+func TestDiskCacheGetPut(t *testing.T) {
+	d, path, cleanup := newTestDiskCache(t)
+	defer cleanup()
+	if err := d.setVersion("v1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var v testValue
+	if d.get("a", &v) {
+		t.Errorf("expected no value for missing key")
+	}
+	for _, kv := range [][2]string{{"a", "first"}, {"b", "second"}, {"a", "third"}} {
+		if err := d.put(kv[0], testValue{kv[1]}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	expectValue(t, d, "a", "third")
+	expectValue(t, d, "b", "second")
+
+	d = reopen(t, d, path)
+	defer d.Close()
+	if d.version != "v1" {
+		t.Errorf("expected version v1, got %s", d.version)
+	}
+	expectValue(t, d, "a", "third")
+	expectValue(t, d, "b", "second")
+}
+
+// TestDiskCacheLoad tests that a partially written record is discarded and
+// that superseded records are compacted away when the file is opened.
+func TestDiskCacheLoad(t *testing.T) {
+	d, path, cleanup := newTestDiskCache(t)
+	defer cleanup()
+	if err := d.setVersion("v1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, name := range []string{"first", "second"} {
+		if err := d.put("a", testValue{name}); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if err := d.put("b", testValue{"other"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	// Simulate a write interrupted partway through a record.
+	partial := diskCacheRecord("c", []byte(`{"name":"partial"}`))
+	if _, err := d.f.WriteAt(partial[:len(partial)-3], d.end); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	d = reopen(t, d, path)
+	defer d.Close()
+	var v testValue
+	if d.get("c", &v) {
+		t.Errorf("expected partial record to be discarded")
+	}
+	expectValue(t, d, "a", "second")
+	expectValue(t, d, "b", "other")
+	if g := d.garbage(); g != 0 {
+		t.Errorf("expected no garbage after compaction, got %d bytes", g)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if fi.Size() != d.end {
+		t.Errorf("expected file size %d, got %d", d.end, fi.Size())
+	}
+}
+
+// TestDiskCacheLoadInvalid tests that a file that is not a disk cache is
+// discarded.
+func TestDiskCacheLoadInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "motki-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "static.cache")
+	if err = ioutil.WriteFile(path, []byte("not a cache"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	d, err := openDiskCache(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer d.Close()
+	if d.version != "" || len(d.index) != 0 {
+		t.Errorf("expected empty cache, got version %q with %d keys", d.version, len(d.index))
+	}
+}
+
+// TestDiskCacheReset tests that changing the version discards all values.
+func TestDiskCacheReset(t *testing.T) {
+	d, path, cleanup := newTestDiskCache(t)
+	defer cleanup()
+	if err := d.setVersion("v1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := d.put("a", testValue{"first"}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := d.setVersion("v1"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expectValue(t, d, "a", "first")
+	if err := d.setVersion("v2"); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	var v testValue
+	if d.get("a", &v) {
+		t.Errorf("expected value to be discarded with the old version")
+	}
+
+	d = reopen(t, d, path)
+	defer d.Close()
+	if d.version != "v2" || len(d.index) != 0 {
+		t.Errorf("expected empty cache with version v2, got version %q with %d keys", d.version, len(d.index))
+	}
+}
+
+// TestDiskCacheLock tests that a disk cache cannot be opened twice.
+func TestDiskCacheLock(t *testing.T) {
+	if !fileLocking {
+		t.Skip("files are not locked on this platform")
+	}
+	d, path, cleanup := newTestDiskCache(t)
+	defer cleanup()
+	if _, err := openDiskCache(path); err != errDiskCacheLocked {
+		t.Errorf("expected errDiskCacheLocked, got %v", err)
+	}
+	d = reopen(t, d, path)
+	d.Close()
+}
+
+// TestCachingClientClose tests that the disk cache is not used once the client
+// is closed.
+func TestCachingClientClose(t *testing.T) {
+	d, _, cleanup := newTestDiskCache(t)
+	defer cleanup()
+	c := &cachingGRPCClient{disk: d}
+	if err := c.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if c.diskTier() != nil {
+		t.Errorf("expected no disk cache after close")
+	}
+	if err := c.Close(); err != nil {
+		t.Errorf("unexpected error closing twice: %s", err.Error())
+	}
+}
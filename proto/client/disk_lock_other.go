@@ -0,0 +1,14 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package client
+
+import "os"
+
+// fileLocking is true if lockFile prevents other processes from using a file.
+const fileLocking = false
+
+// lockFile does nothing; files are not locked on this platform.
+func lockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package client
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileLocking is true if lockFile prevents other processes from using a file.
+const fileLocking = true
+
+// lockFile acquires an exclusive lock on the file, failing immediately if it
+// is held by another process. The lock is released when the file is closed.
+func lockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+}
@@ -9,7 +9,6 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/test/bufconn"
 
-	"github.com/motki/core/cache"
 	"github.com/motki/core/log"
 )
 
@@ -63,13 +62,25 @@ func newGRPCClient(m *bootstrap) *GRPCClient {
 }
 
 // newRemoteGRPC creates a new GRPC client intended for use with a remote GRPC server.
-func newRemoteGRPC(serverAddr string, l log.Logger, tlsConf *tls.Config) (*cachingGRPCClient, error) {
+//
+// If diskPath is not empty, static data is also cached in a file at that path.
+func newRemoteGRPC(serverAddr string, l log.Logger, tlsConf *tls.Config, diskPath string) (*cachingGRPCClient, error) {
 	m := &bootstrap{
 		serverAddr: serverAddr,
 		dialOpts:   []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConf))},
 		logger:     l,
 	}
-	return &cachingGRPCClient{newGRPCClient(m), cache.New(cacheTTL)}, nil
+	var disk *diskCache
+	if diskPath != "" {
+		var err error
+		disk, err = openDiskCache(diskPath)
+		if err == errDiskCacheLocked {
+			l.Warnf("grpc client: disk cache at %s is in use by another process, not using it", diskPath)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return newCachingGRPCClient(m, disk), nil
 }
 
 // newLocalGRPC creates a new GRPC client for use with a process-local GRPC server.
@@ -84,6 +95,6 @@ func newLocalGRPC(lis *bufconn.Listener, l log.Logger) (*cachingGRPCClient, erro
 				return lis.Dial()
 			}),
 			grpc.WithInsecure()}}
-	cl := &cachingGRPCClient{newGRPCClient(m), cache.New(cacheTTL)}
+	cl := newCachingGRPCClient(m, nil)
 	return cl, nil
 }
@@ -1,34 +1,135 @@
 package client
 
 import (
+	"reflect"
+	"sort"
 	"strconv"
-
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/motki/core/cache"
 	"github.com/motki/core/evedb"
+	"github.com/motki/core/log"
 	"github.com/motki/core/model"
-	"github.com/pkg/errors"
 )
 
 // Cache time-to-live for static data.
 const cacheTTL = 600 * time.Second
 
+// Maximum number of values held in the cache at once.
+const cacheCapacity = 10000
+
 // cachingGRPCClient wraps a GRPC client and provides short-lived, in-memory
 // caching for static data retrieved from a remote GRPC server.
+//
+// If configured with a disk cache, static data is also persisted locally so
+// that it survives restarts. The disk cache is consulted after the in-memory
+// cache and before the remote server, and is discarded whenever the server's
+// static dump version changes.
 type cachingGRPCClient struct {
 	*GRPCClient
 
 	cache *cache.Bucket
+
+	disk     *diskCache // Optional, persistent cache tier.
+	diskMu   sync.Mutex // Guards disk.
+	diskOnce sync.Once  // Guards the initial version check of disk.
+
+	logger log.Logger
+}
+
+func newCachingGRPCClient(m *bootstrap, disk *diskCache) *cachingGRPCClient {
+	return &cachingGRPCClient{
+		GRPCClient: newGRPCClient(m),
+		cache:      cache.NewWithCapacity(cacheTTL, cacheCapacity),
+		disk:       disk,
+		logger:     m.logger,
+	}
 }
 
 func cacheKey(prefix string, id int) string {
 	return prefix + strconv.Itoa(id)
 }
 
+// diskTier returns the disk cache, or nil if it is not configured, usable or
+// the client has been closed.
+//
+// The first call stamps the disk cache with the server's static dump version,
+// discarding any data cached for a different version.
+func (c *cachingGRPCClient) diskTier() *diskCache {
+	c.diskOnce.Do(func() {
+		d := c.getDisk()
+		if d == nil {
+			return
+		}
+		v, err := c.GRPCClient.GetSDEVersion()
+		if err == nil {
+			err = d.setVersion(v)
+		}
+		if err != nil {
+			c.logger.Warnf("grpc client: disabling disk cache, unable to verify version: %s", err.Error())
+			c.closeDisk(d)
+		}
+	})
+	return c.getDisk()
+}
+
+func (c *cachingGRPCClient) getDisk() *diskCache {
+	c.diskMu.Lock()
+	defer c.diskMu.Unlock()
+	return c.disk
+}
+
+// closeDisk removes the given disk cache from the client and closes it, if it
+// has not already been removed.
+func (c *cachingGRPCClient) closeDisk(d *diskCache) error {
+	c.diskMu.Lock()
+	if d == nil || c.disk != d {
+		c.diskMu.Unlock()
+		return nil
+	}
+	c.disk = nil
+	c.diskMu.Unlock()
+	return d.Close()
+}
+
+// Close closes the disk cache, if any. Static data is then fetched from the
+// server and cached only in memory.
+func (c *cachingGRPCClient) Close() error {
+	return c.closeDisk(c.getDisk())
+}
+
+// memoize returns the value for the given key from memory, then from disk,
+// and finally by calling vfn.
+//
+// typ must be a nil value of the type returned by vfn; it is used to decode
+// values read from disk.
+func (c *cachingGRPCClient) memoize(key string, typ interface{}, vfn func() (cache.Value, error)) (cache.Value, error) {
+	return c.cache.Memoize(key, func() (cache.Value, error) {
+		d := c.diskTier()
+		if d == nil {
+			return vfn()
+		}
+		dst := reflect.New(reflect.TypeOf(typ))
+		if d.get(key, dst.Interface()) {
+			return dst.Elem().Interface(), nil
+		}
+		v, err := vfn()
+		if err != nil {
+			return nil, err
+		}
+		if err = d.put(key, v); err != nil {
+			c.logger.Warnf("grpc client: unable to write to disk cache: %s", err.Error())
+		}
+		return v, nil
+	})
+}
+
 // GetRegion returns information about the given region ID.
 func (c *cachingGRPCClient) GetRegion(regionID int) (*evedb.Region, error) {
-	v, err := c.cache.Memoize(cacheKey("region:", regionID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("region:", regionID), (*evedb.Region)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetRegion(regionID)
 	})
 	if err != nil {
@@ -42,7 +143,7 @@ func (c *cachingGRPCClient) GetRegion(regionID int) (*evedb.Region, error) {
 
 // GetRegions returns a slice containing information about all regions in the EVE universe.
 func (c *cachingGRPCClient) GetRegions() ([]*evedb.Region, error) {
-	v, err := c.cache.Memoize("regions", func() (cache.Value, error) {
+	v, err := c.memoize("regions", ([]*evedb.Region)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetRegions()
 	})
 	if err != nil {
@@ -56,7 +157,7 @@ func (c *cachingGRPCClient) GetRegions() ([]*evedb.Region, error) {
 
 // GetSystem returns information about the given system ID.
 func (c *cachingGRPCClient) GetSystem(systemID int) (*evedb.System, error) {
-	v, err := c.cache.Memoize(cacheKey("system:", systemID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("system:", systemID), (*evedb.System)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetSystem(systemID)
 	})
 	if err != nil {
@@ -68,9 +169,51 @@ func (c *cachingGRPCClient) GetSystem(systemID int) (*evedb.System, error) {
 	return nil, errors.Errorf("expected *evedb.System from cache, got %T", v)
 }
 
+// routeCacheKey returns the cache key for a route.
+func routeCacheKey(originID, destinationID int, flag evedb.RouteFlag, avoidIDs []int) string {
+	avoid := make([]int, len(avoidIDs))
+	copy(avoid, avoidIDs)
+	sort.Ints(avoid)
+	key := "route:" + string(flag) + ":" + strconv.Itoa(originID) + ":" + strconv.Itoa(destinationID)
+	for _, id := range avoid {
+		key += ":" + strconv.Itoa(id)
+	}
+	return key
+}
+
+// GetRoute returns the route between the origin and destination systems.
+func (c *cachingGRPCClient) GetRoute(originID, destinationID int, flag evedb.RouteFlag, avoidIDs ...int) (*evedb.Route, error) {
+	key := routeCacheKey(originID, destinationID, flag, avoidIDs)
+	v, err := c.memoize(key, (*evedb.Route)(nil), func() (cache.Value, error) {
+		return c.GRPCClient.GetRoute(originID, destinationID, flag, avoidIDs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a, ok := v.(*evedb.Route); ok {
+		return a, nil
+	}
+	return nil, errors.Errorf("expected *evedb.Route from cache, got %T", v)
+}
+
+// GetJumps returns the number of jumps in the shortest route between two systems.
+func (c *cachingGRPCClient) GetJumps(originID, destinationID int) (int, error) {
+	key := "jumps:" + strconv.Itoa(originID) + ":" + strconv.Itoa(destinationID)
+	v, err := c.memoize(key, 0, func() (cache.Value, error) {
+		return c.GRPCClient.GetJumps(originID, destinationID)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if a, ok := v.(int); ok {
+		return a, nil
+	}
+	return 0, errors.Errorf("expected int from cache, got %T", v)
+}
+
 // GetConstellation returns information about the given constellation ID.
 func (c *cachingGRPCClient) GetConstellation(constellationID int) (*evedb.Constellation, error) {
-	v, err := c.cache.Memoize(cacheKey("constellation:", constellationID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("constellation:", constellationID), (*evedb.Constellation)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetConstellation(constellationID)
 	})
 	if err != nil {
@@ -84,7 +227,7 @@ func (c *cachingGRPCClient) GetConstellation(constellationID int) (*evedb.Conste
 
 // GetRace returns information about the given race ID.
 func (c *cachingGRPCClient) GetRace(raceID int) (*evedb.Race, error) {
-	v, err := c.cache.Memoize(cacheKey("race:", raceID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("race:", raceID), (*evedb.Race)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetRace(raceID)
 	})
 	if err != nil {
@@ -98,7 +241,7 @@ func (c *cachingGRPCClient) GetRace(raceID int) (*evedb.Race, error) {
 
 // GetRaces returns information about all races in the EVE universe.
 func (c *cachingGRPCClient) GetRaces() ([]*evedb.Race, error) {
-	v, err := c.cache.Memoize("races", func() (cache.Value, error) {
+	v, err := c.memoize("races", ([]*evedb.Race)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetRaces()
 	})
 	if err != nil {
@@ -112,7 +255,7 @@ func (c *cachingGRPCClient) GetRaces() ([]*evedb.Race, error) {
 
 // GetBloodline returns information about the given bloodline ID.
 func (c *cachingGRPCClient) GetBloodline(bloodlineID int) (*evedb.Bloodline, error) {
-	v, err := c.cache.Memoize(cacheKey("bloodline:", bloodlineID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("bloodline:", bloodlineID), (*evedb.Bloodline)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetBloodline(bloodlineID)
 	})
 	if err != nil {
@@ -126,7 +269,7 @@ func (c *cachingGRPCClient) GetBloodline(bloodlineID int) (*evedb.Bloodline, err
 
 // GetAncestry returns information about the given ancestry ID.
 func (c *cachingGRPCClient) GetAncestry(ancestryID int) (*evedb.Ancestry, error) {
-	v, err := c.cache.Memoize(cacheKey("ancestry:", ancestryID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("ancestry:", ancestryID), (*evedb.Ancestry)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetAncestry(ancestryID)
 	})
 	if err != nil {
@@ -140,7 +283,7 @@ func (c *cachingGRPCClient) GetAncestry(ancestryID int) (*evedb.Ancestry, error)
 
 // GetItemType returns information about the given type ID.
 func (c *cachingGRPCClient) GetItemType(typeID int) (*evedb.ItemType, error) {
-	v, err := c.cache.Memoize(cacheKey("item:", typeID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("item:", typeID), (*evedb.ItemType)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetItemType(typeID)
 	})
 	if err != nil {
@@ -154,7 +297,7 @@ func (c *cachingGRPCClient) GetItemType(typeID int) (*evedb.ItemType, error) {
 
 // GetItemTypeDetail returns detailed information about the given type ID.
 func (c *cachingGRPCClient) GetItemTypeDetail(typeID int) (*evedb.ItemTypeDetail, error) {
-	v, err := c.cache.Memoize(cacheKey("item_detail:", typeID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("item_detail:", typeID), (*evedb.ItemTypeDetail)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetItemTypeDetail(typeID)
 	})
 	if err != nil {
@@ -168,7 +311,7 @@ func (c *cachingGRPCClient) GetItemTypeDetail(typeID int) (*evedb.ItemTypeDetail
 
 // GetMaterialSheet returns manufacturing information about the given type ID.
 func (c *cachingGRPCClient) GetMaterialSheet(typeID int) (*evedb.MaterialSheet, error) {
-	v, err := c.cache.Memoize(cacheKey("mat_sheet:", typeID), func() (cache.Value, error) {
+	v, err := c.memoize(cacheKey("mat_sheet:", typeID), (*evedb.MaterialSheet)(nil), func() (cache.Value, error) {
 		return c.GRPCClient.GetMaterialSheet(typeID)
 	})
 	if err != nil {
@@ -180,6 +323,51 @@ func (c *cachingGRPCClient) GetMaterialSheet(typeID int) (*evedb.MaterialSheet,
 	return nil, errors.Errorf("expected *evedb.MaterialSheet from cache, got %T", v)
 }
 
+// GetIndustryBlueprint returns the industry activities that can be performed
+// using the given blueprint type ID.
+func (c *cachingGRPCClient) GetIndustryBlueprint(typeID int) (*evedb.Blueprint, error) {
+	v, err := c.memoize(cacheKey("industry_bp:", typeID), (*evedb.Blueprint)(nil), func() (cache.Value, error) {
+		return c.GRPCClient.GetIndustryBlueprint(typeID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a, ok := v.(*evedb.Blueprint); ok {
+		return a, nil
+	}
+	return nil, errors.Errorf("expected *evedb.Blueprint from cache, got %T", v)
+}
+
+// GetIndustryBlueprintByProduct returns the blueprint that produces the given
+// type ID using the given activity.
+func (c *cachingGRPCClient) GetIndustryBlueprintByProduct(productTypeID int, activity evedb.ActivityType) (*evedb.Blueprint, error) {
+	key := cacheKey("industry_bp_product:"+strconv.Itoa(int(activity))+":", productTypeID)
+	v, err := c.memoize(key, (*evedb.Blueprint)(nil), func() (cache.Value, error) {
+		return c.GRPCClient.GetIndustryBlueprintByProduct(productTypeID, activity)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a, ok := v.(*evedb.Blueprint); ok {
+		return a, nil
+	}
+	return nil, errors.Errorf("expected *evedb.Blueprint from cache, got %T", v)
+}
+
+// GetTypeDogma returns the dogma attributes and effects of the given type ID.
+func (c *cachingGRPCClient) GetTypeDogma(typeID int) (*evedb.TypeDogma, error) {
+	v, err := c.memoize(cacheKey("dogma:", typeID), (*evedb.TypeDogma)(nil), func() (cache.Value, error) {
+		return c.GRPCClient.GetTypeDogma(typeID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a, ok := v.(*evedb.TypeDogma); ok {
+		return a, nil
+	}
+	return nil, errors.Errorf("expected *evedb.TypeDogma from cache, got %T", v)
+}
+
 // GetLocation returns location information for a denormalized location ID.
 func (c *cachingGRPCClient) GetLocation(locationID int) (*model.Location, error) {
 	v, err := c.cache.Memoize(cacheKey("location:", locationID), func() (cache.Value, error) {
@@ -16,6 +16,26 @@ type EVEUniverseClient struct {
 	*bootstrap
 }
 
+// GetSDEVersion returns an identifier for the static dump installed on the server.
+func (c *EVEUniverseClient) GetSDEVersion() (string, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	res, err := service.GetVersion(
+		context.Background(),
+		&proto.GetVersionRequest{})
+	if err != nil {
+		return "", err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return "", errors.New(res.Result.Description)
+	}
+	return res.Version, nil
+}
+
 // GetRegion returns information about the given region ID.
 func (c *EVEUniverseClient) GetRegion(regionID int) (*evedb.Region, error) {
 	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
@@ -88,6 +108,63 @@ func (c *EVEUniverseClient) GetSystem(systemID int) (*evedb.System, error) {
 	return proto.ProtoToSystem(pres), nil
 }
 
+// GetRoute returns the route between the origin and destination systems.
+//
+// The route is chosen according to flag and does not pass through any of the
+// avoided systems.
+func (c *EVEUniverseClient) GetRoute(originID, destinationID int, flag evedb.RouteFlag, avoidIDs ...int) (*evedb.Route, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	var avoid []int64
+	for _, id := range avoidIDs {
+		avoid = append(avoid, int64(id))
+	}
+	res, err := service.GetRoute(
+		context.Background(),
+		&proto.GetRouteRequest{
+			OriginId:      int64(originID),
+			DestinationId: int64(destinationID),
+			Flag:          string(flag),
+			AvoidId:       avoid,
+		})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	pres := res.Route
+	if pres == nil {
+		return nil, errors.New("expected route in grpc response, got nil")
+	}
+	return proto.ProtoToRoute(pres), nil
+}
+
+// GetJumps returns the number of jumps in the shortest route between the
+// origin and destination systems.
+func (c *EVEUniverseClient) GetJumps(originID, destinationID int) (int, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	res, err := service.GetJumps(
+		context.Background(),
+		&proto.GetJumpsRequest{OriginId: int64(originID), DestinationId: int64(destinationID)})
+	if err != nil {
+		return 0, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return 0, errors.New(res.Result.Description)
+	}
+	return int(res.Jumps), nil
+}
+
 // GetConstellation returns information about the given constellation ID.
 func (c *EVEUniverseClient) GetConstellation(constellationID int) (*evedb.Constellation, error) {
 	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
@@ -149,3 +149,126 @@ func (c *ItemTypeClient) GetMaterialSheet(typeID int) (*evedb.MaterialSheet, err
 	}
 	return proto.ProtoToMatSheet(pres), nil
 }
+
+// GetIndustryBlueprint returns the industry activities that can be performed
+// using the given blueprint type ID.
+func (c *ItemTypeClient) GetIndustryBlueprint(typeID int) (*evedb.Blueprint, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	res, err := service.GetIndustryBlueprint(
+		context.Background(),
+		&proto.GetIndustryBlueprintRequest{TypeId: int64(typeID)})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	pres := res.Blueprint
+	if pres == nil {
+		return nil, errors.New("expected blueprint in grpc response, got nil")
+	}
+	return proto.ProtoToIndustryBlueprint(pres), nil
+}
+
+// GetIndustryBlueprintByProduct returns the blueprint that produces the given
+// type ID using the given activity.
+func (c *ItemTypeClient) GetIndustryBlueprintByProduct(productTypeID int, activity evedb.ActivityType) (*evedb.Blueprint, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	res, err := service.GetIndustryBlueprintByProduct(
+		context.Background(),
+		&proto.GetIndustryBlueprintByProductRequest{
+			ProductTypeId: int64(productTypeID),
+			ActivityId:    int64(activity),
+		})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	pres := res.Blueprint
+	if pres == nil {
+		return nil, errors.New("expected blueprint in grpc response, got nil")
+	}
+	return proto.ProtoToIndustryBlueprint(pres), nil
+}
+
+// GetTypeDogma returns the dogma attributes and effects of the given type ID.
+func (c *ItemTypeClient) GetTypeDogma(typeID int) (*evedb.TypeDogma, error) {
+	res, err := c.GetTypeDogmas(typeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 {
+		return nil, errors.Errorf("expected 1 result in grpc response, got %d", len(res))
+	}
+	return res[0], nil
+}
+
+// GetTypeDogmas returns the dogma attributes and effects of each of the given
+// type IDs, in the order given.
+func (c *ItemTypeClient) GetTypeDogmas(typeIDs ...int) ([]*evedb.TypeDogma, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	var ids []int64
+	for _, id := range typeIDs {
+		ids = append(ids, int64(id))
+	}
+	res, err := service.GetTypeDogma(
+		context.Background(),
+		&proto.GetTypeDogmaRequest{TypeId: ids})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	var results []*evedb.TypeDogma
+	for _, d := range res.Dogma {
+		results = append(results, proto.ProtoToTypeDogma(d))
+	}
+	return results, nil
+}
+
+// GetAttributeTypes returns information about each of the given dogma
+// attribute IDs.
+func (c *ItemTypeClient) GetAttributeTypes(attributeIDs ...int) ([]*evedb.AttributeType, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewEveDBServiceClient(conn)
+	var ids []int64
+	for _, id := range attributeIDs {
+		ids = append(ids, int64(id))
+	}
+	res, err := service.GetAttributeTypes(
+		context.Background(),
+		&proto.GetAttributeTypesRequest{AttributeId: ids})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	var results []*evedb.AttributeType
+	for _, a := range res.Attribute {
+		results = append(results, proto.ProtoToAttributeType(a))
+	}
+	return results, nil
+}
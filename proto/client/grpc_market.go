@@ -66,3 +66,62 @@ func (c *MarketClient) GetMarketPrice(typeID int) (*model.MarketPrice, error) {
 	}
 	return nil, errors.Errorf("expected grpc response to price for typeID %d, got none", typeID)
 }
+
+// GetMarketHistory returns the daily history for the given type in the given
+// region over the last number of days, along with moving averages over the
+// given window.
+//
+// If days or window is zero, the server's default is used.
+func (c *MarketClient) GetMarketHistory(regionID, typeID, days, window int) (*model.MarketTrend, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewMarketPriceServiceClient(conn)
+	res, err := service.GetMarketHistory(
+		context.Background(),
+		&proto.GetMarketHistoryRequest{
+			Token:    &proto.Token{Identifier: c.token},
+			RegionId: int64(regionID),
+			TypeId:   int64(typeID),
+			Days:     int32(days),
+			Window:   int32(window),
+		})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	return proto.ProtoToMarketTrend(res.Trend), nil
+}
+
+// Reprocess returns the materials produced by reprocessing the given items
+// with the given yield, skills and tax, valued at current market prices.
+func (c *MarketClient) Reprocess(params model.ReprocessParams, items ...*model.ReprocessItem) (*model.ReprocessResult, error) {
+	conn, err := grpc.Dial(c.serverAddr, c.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	service := proto.NewReprocessingServiceClient(conn)
+	var pitems []*proto.ReprocessItem
+	for _, it := range items {
+		pitems = append(pitems, proto.ReprocessItemToProto(it))
+	}
+	res, err := service.Reprocess(
+		context.Background(),
+		&proto.ReprocessRequest{
+			Token:  &proto.Token{Identifier: c.token},
+			Item:   pitems,
+			Params: proto.ReprocessParamsToProto(params),
+		})
+	if err != nil {
+		return nil, err
+	}
+	if res.Result.Status == proto.Status_FAILURE {
+		return nil, errors.New(res.Result.Description)
+	}
+	return proto.ProtoToReprocessResult(res.Reprocessed), nil
+}
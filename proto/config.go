@@ -3,6 +3,8 @@ package proto
 import (
 	"crypto/tls"
 	"net"
+	"os"
+	"path/filepath"
 
 	"golang.org/x/crypto/acme/autocert"
 	"google.golang.org/grpc/test/bufconn"
@@ -31,6 +33,10 @@ type Config struct {
 type RemoteConfig struct {
 	ServerAddr         string `toml:"addr"`
 	InsecureSkipVerify bool   `toml:"insecure_skip_verify_ssl"`
+
+	// If enabled, static data is cached on disk so that it survives restarts.
+	DiskCache     bool   `toml:"disk_cache"`
+	DiskCachePath string `toml:"disk_cache_path"` // Defaults to a file in the user's cache directory.
 }
 
 type ServerConfig struct {
@@ -44,6 +50,18 @@ type ServerConfig struct {
 	listenPort string
 }
 
+// DiskCacheFile returns the path to the disk cache file.
+func (c RemoteConfig) DiskCacheFile() (string, error) {
+	if c.DiskCachePath != "" {
+		return c.DiskCachePath, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "motki", "static.cache"), nil
+}
+
 // TLSConfig attempts to load the configured certificate.
 func (c RemoteConfig) TLSConfig() (*tls.Config, error) {
 	return &tls.Config{NextProtos: []string{"h2"}, InsecureSkipVerify: c.InsecureSkipVerify}, nil
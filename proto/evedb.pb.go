@@ -37,7 +37,7 @@ func (m *Icon) Reset()         { *m = Icon{} }
 func (m *Icon) String() string { return proto.CompactTextString(m) }
 func (*Icon) ProtoMessage()    {}
 func (*Icon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{0}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{0}
 }
 func (m *Icon) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Icon.Unmarshal(m, b)
@@ -94,7 +94,7 @@ func (m *Race) Reset()         { *m = Race{} }
 func (m *Race) String() string { return proto.CompactTextString(m) }
 func (*Race) ProtoMessage()    {}
 func (*Race) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{1}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{1}
 }
 func (m *Race) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Race.Unmarshal(m, b)
@@ -171,7 +171,7 @@ func (m *Ancestry) Reset()         { *m = Ancestry{} }
 func (m *Ancestry) String() string { return proto.CompactTextString(m) }
 func (*Ancestry) ProtoMessage()    {}
 func (*Ancestry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{2}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{2}
 }
 func (m *Ancestry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ancestry.Unmarshal(m, b)
@@ -296,7 +296,7 @@ func (m *Bloodline) Reset()         { *m = Bloodline{} }
 func (m *Bloodline) String() string { return proto.CompactTextString(m) }
 func (*Bloodline) ProtoMessage()    {}
 func (*Bloodline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{3}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{3}
 }
 func (m *Bloodline) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bloodline.Unmarshal(m, b)
@@ -451,7 +451,7 @@ func (m *System) Reset()         { *m = System{} }
 func (m *System) String() string { return proto.CompactTextString(m) }
 func (*System) ProtoMessage()    {}
 func (*System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{4}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{4}
 }
 func (m *System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_System.Unmarshal(m, b)
@@ -520,7 +520,7 @@ func (m *Constellation) Reset()         { *m = Constellation{} }
 func (m *Constellation) String() string { return proto.CompactTextString(m) }
 func (*Constellation) ProtoMessage()    {}
 func (*Constellation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{5}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{5}
 }
 func (m *Constellation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Constellation.Unmarshal(m, b)
@@ -574,7 +574,7 @@ func (m *Region) Reset()         { *m = Region{} }
 func (m *Region) String() string { return proto.CompactTextString(m) }
 func (*Region) ProtoMessage()    {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{6}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{6}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Region.Unmarshal(m, b)
@@ -626,7 +626,7 @@ func (m *Station) Reset()         { *m = Station{} }
 func (m *Station) String() string { return proto.CompactTextString(m) }
 func (*Station) ProtoMessage()    {}
 func (*Station) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{7}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{7}
 }
 func (m *Station) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Station.Unmarshal(m, b)
@@ -709,7 +709,7 @@ func (m *ItemType) Reset()         { *m = ItemType{} }
 func (m *ItemType) String() string { return proto.CompactTextString(m) }
 func (*ItemType) ProtoMessage()    {}
 func (*ItemType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{8}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{8}
 }
 func (m *ItemType) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ItemType.Unmarshal(m, b)
@@ -776,7 +776,7 @@ func (m *ItemTypeDetail) Reset()         { *m = ItemTypeDetail{} }
 func (m *ItemTypeDetail) String() string { return proto.CompactTextString(m) }
 func (*ItemTypeDetail) ProtoMessage()    {}
 func (*ItemTypeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{9}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{9}
 }
 func (m *ItemTypeDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ItemTypeDetail.Unmarshal(m, b)
@@ -915,7 +915,7 @@ func (m *MaterialSheet) Reset()         { *m = MaterialSheet{} }
 func (m *MaterialSheet) String() string { return proto.CompactTextString(m) }
 func (*MaterialSheet) ProtoMessage()    {}
 func (*MaterialSheet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{10}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{10}
 }
 func (m *MaterialSheet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MaterialSheet.Unmarshal(m, b)
@@ -969,7 +969,7 @@ func (m *Material) Reset()         { *m = Material{} }
 func (m *Material) String() string { return proto.CompactTextString(m) }
 func (*Material) ProtoMessage()    {}
 func (*Material) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{11}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{11}
 }
 func (m *Material) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Material.Unmarshal(m, b)
@@ -1014,7 +1014,7 @@ func (m *GetRegionRequest) Reset()         { *m = GetRegionRequest{} }
 func (m *GetRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionRequest) ProtoMessage()    {}
 func (*GetRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{12}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{12}
 }
 func (m *GetRegionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRegionRequest.Unmarshal(m, b)
@@ -1053,7 +1053,7 @@ func (m *GetRegionResponse) Reset()         { *m = GetRegionResponse{} }
 func (m *GetRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegionResponse) ProtoMessage()    {}
 func (*GetRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{13}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{13}
 }
 func (m *GetRegionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRegionResponse.Unmarshal(m, b)
@@ -1097,7 +1097,7 @@ func (m *GetRegionsRequest) Reset()         { *m = GetRegionsRequest{} }
 func (m *GetRegionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionsRequest) ProtoMessage()    {}
 func (*GetRegionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{14}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{14}
 }
 func (m *GetRegionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRegionsRequest.Unmarshal(m, b)
@@ -1129,7 +1129,7 @@ func (m *GetRegionsResponse) Reset()         { *m = GetRegionsResponse{} }
 func (m *GetRegionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegionsResponse) ProtoMessage()    {}
 func (*GetRegionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{15}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{15}
 }
 func (m *GetRegionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRegionsResponse.Unmarshal(m, b)
@@ -1174,7 +1174,7 @@ func (m *GetConstellationRequest) Reset()         { *m = GetConstellationRequest
 func (m *GetConstellationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetConstellationRequest) ProtoMessage()    {}
 func (*GetConstellationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{16}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{16}
 }
 func (m *GetConstellationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetConstellationRequest.Unmarshal(m, b)
@@ -1213,7 +1213,7 @@ func (m *GetConstellationResponse) Reset()         { *m = GetConstellationRespon
 func (m *GetConstellationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetConstellationResponse) ProtoMessage()    {}
 func (*GetConstellationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{17}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{17}
 }
 func (m *GetConstellationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetConstellationResponse.Unmarshal(m, b)
@@ -1258,7 +1258,7 @@ func (m *GetSystemRequest) Reset()         { *m = GetSystemRequest{} }
 func (m *GetSystemRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSystemRequest) ProtoMessage()    {}
 func (*GetSystemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{18}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{18}
 }
 func (m *GetSystemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSystemRequest.Unmarshal(m, b)
@@ -1297,7 +1297,7 @@ func (m *GetSystemResponse) Reset()         { *m = GetSystemResponse{} }
 func (m *GetSystemResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSystemResponse) ProtoMessage()    {}
 func (*GetSystemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{19}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{19}
 }
 func (m *GetSystemResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSystemResponse.Unmarshal(m, b)
@@ -1342,7 +1342,7 @@ func (m *GetRaceRequest) Reset()         { *m = GetRaceRequest{} }
 func (m *GetRaceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRaceRequest) ProtoMessage()    {}
 func (*GetRaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{20}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{20}
 }
 func (m *GetRaceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRaceRequest.Unmarshal(m, b)
@@ -1381,7 +1381,7 @@ func (m *GetRaceResponse) Reset()         { *m = GetRaceResponse{} }
 func (m *GetRaceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRaceResponse) ProtoMessage()    {}
 func (*GetRaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{21}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{21}
 }
 func (m *GetRaceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRaceResponse.Unmarshal(m, b)
@@ -1425,7 +1425,7 @@ func (m *GetRacesRequest) Reset()         { *m = GetRacesRequest{} }
 func (m *GetRacesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRacesRequest) ProtoMessage()    {}
 func (*GetRacesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{22}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{22}
 }
 func (m *GetRacesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRacesRequest.Unmarshal(m, b)
@@ -1457,7 +1457,7 @@ func (m *GetRacesResponse) Reset()         { *m = GetRacesResponse{} }
 func (m *GetRacesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRacesResponse) ProtoMessage()    {}
 func (*GetRacesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{23}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{23}
 }
 func (m *GetRacesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRacesResponse.Unmarshal(m, b)
@@ -1502,7 +1502,7 @@ func (m *GetBloodlineRequest) Reset()         { *m = GetBloodlineRequest{} }
 func (m *GetBloodlineRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBloodlineRequest) ProtoMessage()    {}
 func (*GetBloodlineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{24}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{24}
 }
 func (m *GetBloodlineRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBloodlineRequest.Unmarshal(m, b)
@@ -1541,7 +1541,7 @@ func (m *GetBloodlineResponse) Reset()         { *m = GetBloodlineResponse{} }
 func (m *GetBloodlineResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBloodlineResponse) ProtoMessage()    {}
 func (*GetBloodlineResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{25}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{25}
 }
 func (m *GetBloodlineResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBloodlineResponse.Unmarshal(m, b)
@@ -1586,7 +1586,7 @@ func (m *GetAncestryRequest) Reset()         { *m = GetAncestryRequest{} }
 func (m *GetAncestryRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAncestryRequest) ProtoMessage()    {}
 func (*GetAncestryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{26}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{26}
 }
 func (m *GetAncestryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestryRequest.Unmarshal(m, b)
@@ -1625,7 +1625,7 @@ func (m *GetAncestryResponse) Reset()         { *m = GetAncestryResponse{} }
 func (m *GetAncestryResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAncestryResponse) ProtoMessage()    {}
 func (*GetAncestryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{27}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{27}
 }
 func (m *GetAncestryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestryResponse.Unmarshal(m, b)
@@ -1670,7 +1670,7 @@ func (m *GetItemTypeRequest) Reset()         { *m = GetItemTypeRequest{} }
 func (m *GetItemTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetItemTypeRequest) ProtoMessage()    {}
 func (*GetItemTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{28}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{28}
 }
 func (m *GetItemTypeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetItemTypeRequest.Unmarshal(m, b)
@@ -1709,7 +1709,7 @@ func (m *GetItemTypeResponse) Reset()         { *m = GetItemTypeResponse{} }
 func (m *GetItemTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*GetItemTypeResponse) ProtoMessage()    {}
 func (*GetItemTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{29}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{29}
 }
 func (m *GetItemTypeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetItemTypeResponse.Unmarshal(m, b)
@@ -1754,7 +1754,7 @@ func (m *GetItemTypeDetailRequest) Reset()         { *m = GetItemTypeDetailReque
 func (m *GetItemTypeDetailRequest) String() string { return proto.CompactTextString(m) }
 func (*GetItemTypeDetailRequest) ProtoMessage()    {}
 func (*GetItemTypeDetailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{30}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{30}
 }
 func (m *GetItemTypeDetailRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetItemTypeDetailRequest.Unmarshal(m, b)
@@ -1793,7 +1793,7 @@ func (m *GetItemTypeDetailResponse) Reset()         { *m = GetItemTypeDetailResp
 func (m *GetItemTypeDetailResponse) String() string { return proto.CompactTextString(m) }
 func (*GetItemTypeDetailResponse) ProtoMessage()    {}
 func (*GetItemTypeDetailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{31}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{31}
 }
 func (m *GetItemTypeDetailResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetItemTypeDetailResponse.Unmarshal(m, b)
@@ -1839,7 +1839,7 @@ func (m *QueryItemTypesRequest) Reset()         { *m = QueryItemTypesRequest{} }
 func (m *QueryItemTypesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryItemTypesRequest) ProtoMessage()    {}
 func (*QueryItemTypesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{32}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{32}
 }
 func (m *QueryItemTypesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryItemTypesRequest.Unmarshal(m, b)
@@ -1885,7 +1885,7 @@ func (m *QueryItemTypesResponse) Reset()         { *m = QueryItemTypesResponse{}
 func (m *QueryItemTypesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryItemTypesResponse) ProtoMessage()    {}
 func (*QueryItemTypesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{33}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{33}
 }
 func (m *QueryItemTypesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryItemTypesResponse.Unmarshal(m, b)
@@ -1931,7 +1931,7 @@ func (m *QueryItemTypeDetailsRequest) Reset()         { *m = QueryItemTypeDetail
 func (m *QueryItemTypeDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryItemTypeDetailsRequest) ProtoMessage()    {}
 func (*QueryItemTypeDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{34}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{34}
 }
 func (m *QueryItemTypeDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryItemTypeDetailsRequest.Unmarshal(m, b)
@@ -1977,7 +1977,7 @@ func (m *QueryItemTypeDetailsResponse) Reset()         { *m = QueryItemTypeDetai
 func (m *QueryItemTypeDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryItemTypeDetailsResponse) ProtoMessage()    {}
 func (*QueryItemTypeDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{35}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{35}
 }
 func (m *QueryItemTypeDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryItemTypeDetailsResponse.Unmarshal(m, b)
@@ -2022,7 +2022,7 @@ func (m *GetMaterialSheetRequest) Reset()         { *m = GetMaterialSheetRequest
 func (m *GetMaterialSheetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMaterialSheetRequest) ProtoMessage()    {}
 func (*GetMaterialSheetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{36}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{36}
 }
 func (m *GetMaterialSheetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaterialSheetRequest.Unmarshal(m, b)
@@ -2061,7 +2061,7 @@ func (m *GetMaterialSheetResponse) Reset()         { *m = GetMaterialSheetRespon
 func (m *GetMaterialSheetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMaterialSheetResponse) ProtoMessage()    {}
 func (*GetMaterialSheetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{37}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{37}
 }
 func (m *GetMaterialSheetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaterialSheetResponse.Unmarshal(m, b)
@@ -2106,7 +2106,7 @@ func (m *GetStationRequest) Reset()         { *m = GetStationRequest{} }
 func (m *GetStationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStationRequest) ProtoMessage()    {}
 func (*GetStationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{38}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{38}
 }
 func (m *GetStationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStationRequest.Unmarshal(m, b)
@@ -2145,7 +2145,7 @@ func (m *GetStationResponse) Reset()         { *m = GetStationResponse{} }
 func (m *GetStationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStationResponse) ProtoMessage()    {}
 func (*GetStationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evedb_b05ddaeec1c8004d, []int{39}
+	return fileDescriptor_evedb_7c508332a6f56819, []int{39}
 }
 func (m *GetStationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStationResponse.Unmarshal(m, b)
@@ -2179,6 +2179,1161 @@ func (m *GetStationResponse) GetStation() *Station {
 	return nil
 }
 
+type GetVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionRequest) Reset()         { *m = GetVersionRequest{} }
+func (m *GetVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionRequest) ProtoMessage()    {}
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{40}
+}
+func (m *GetVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionRequest.Unmarshal(m, b)
+}
+func (m *GetVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionRequest.Merge(dst, src)
+}
+func (m *GetVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionRequest.Size(m)
+}
+func (m *GetVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionRequest proto.InternalMessageInfo
+
+type GetVersionResponse struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionResponse) Reset()         { *m = GetVersionResponse{} }
+func (m *GetVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVersionResponse) ProtoMessage()    {}
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{41}
+}
+func (m *GetVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionResponse.Unmarshal(m, b)
+}
+func (m *GetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionResponse.Merge(dst, src)
+}
+func (m *GetVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVersionResponse.Size(m)
+}
+func (m *GetVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionResponse proto.InternalMessageInfo
+
+func (m *GetVersionResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetVersionResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// A Route is a path between two solar systems.
+type Route struct {
+	Flag string `protobuf:"bytes,1,opt,name=flag" json:"flag,omitempty"`
+	// Each system along the route, including the origin and destination.
+	System               []*System `protobuf:"bytes,2,rep,name=system" json:"system,omitempty"`
+	Jumps                int64     `protobuf:"varint,3,opt,name=jumps" json:"jumps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{42}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetFlag() string {
+	if m != nil {
+		return m.Flag
+	}
+	return ""
+}
+
+func (m *Route) GetSystem() []*System {
+	if m != nil {
+		return m.System
+	}
+	return nil
+}
+
+func (m *Route) GetJumps() int64 {
+	if m != nil {
+		return m.Jumps
+	}
+	return 0
+}
+
+type GetRouteRequest struct {
+	OriginId      int64 `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	DestinationId int64 `protobuf:"varint,2,opt,name=destination_id,json=destinationId" json:"destination_id,omitempty"`
+	// One of "shortest", "secure", or "insecure". Defaults to "shortest".
+	Flag string `protobuf:"bytes,3,opt,name=flag" json:"flag,omitempty"`
+	// Systems the route must not pass through.
+	AvoidId              []int64  `protobuf:"varint,4,rep,packed,name=avoid_id,json=avoidId" json:"avoid_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRouteRequest) Reset()         { *m = GetRouteRequest{} }
+func (m *GetRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRouteRequest) ProtoMessage()    {}
+func (*GetRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{43}
+}
+func (m *GetRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRouteRequest.Unmarshal(m, b)
+}
+func (m *GetRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRouteRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRouteRequest.Merge(dst, src)
+}
+func (m *GetRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRouteRequest.Size(m)
+}
+func (m *GetRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRouteRequest proto.InternalMessageInfo
+
+func (m *GetRouteRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *GetRouteRequest) GetDestinationId() int64 {
+	if m != nil {
+		return m.DestinationId
+	}
+	return 0
+}
+
+func (m *GetRouteRequest) GetFlag() string {
+	if m != nil {
+		return m.Flag
+	}
+	return ""
+}
+
+func (m *GetRouteRequest) GetAvoidId() []int64 {
+	if m != nil {
+		return m.AvoidId
+	}
+	return nil
+}
+
+type GetRouteResponse struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Route                *Route   `protobuf:"bytes,2,opt,name=route" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRouteResponse) Reset()         { *m = GetRouteResponse{} }
+func (m *GetRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRouteResponse) ProtoMessage()    {}
+func (*GetRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{44}
+}
+func (m *GetRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRouteResponse.Unmarshal(m, b)
+}
+func (m *GetRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRouteResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRouteResponse.Merge(dst, src)
+}
+func (m *GetRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRouteResponse.Size(m)
+}
+func (m *GetRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRouteResponse proto.InternalMessageInfo
+
+func (m *GetRouteResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetRouteResponse) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+type GetJumpsRequest struct {
+	OriginId             int64    `protobuf:"varint,1,opt,name=origin_id,json=originId" json:"origin_id,omitempty"`
+	DestinationId        int64    `protobuf:"varint,2,opt,name=destination_id,json=destinationId" json:"destination_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJumpsRequest) Reset()         { *m = GetJumpsRequest{} }
+func (m *GetJumpsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJumpsRequest) ProtoMessage()    {}
+func (*GetJumpsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{45}
+}
+func (m *GetJumpsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJumpsRequest.Unmarshal(m, b)
+}
+func (m *GetJumpsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJumpsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetJumpsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJumpsRequest.Merge(dst, src)
+}
+func (m *GetJumpsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJumpsRequest.Size(m)
+}
+func (m *GetJumpsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJumpsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJumpsRequest proto.InternalMessageInfo
+
+func (m *GetJumpsRequest) GetOriginId() int64 {
+	if m != nil {
+		return m.OriginId
+	}
+	return 0
+}
+
+func (m *GetJumpsRequest) GetDestinationId() int64 {
+	if m != nil {
+		return m.DestinationId
+	}
+	return 0
+}
+
+type GetJumpsResponse struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Jumps                int64    `protobuf:"varint,2,opt,name=jumps" json:"jumps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJumpsResponse) Reset()         { *m = GetJumpsResponse{} }
+func (m *GetJumpsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJumpsResponse) ProtoMessage()    {}
+func (*GetJumpsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{46}
+}
+func (m *GetJumpsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJumpsResponse.Unmarshal(m, b)
+}
+func (m *GetJumpsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJumpsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetJumpsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJumpsResponse.Merge(dst, src)
+}
+func (m *GetJumpsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJumpsResponse.Size(m)
+}
+func (m *GetJumpsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJumpsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJumpsResponse proto.InternalMessageInfo
+
+func (m *GetJumpsResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetJumpsResponse) GetJumps() int64 {
+	if m != nil {
+		return m.Jumps
+	}
+	return 0
+}
+
+// An IndustryProduct is a type and quantity of an item produced by an industry activity.
+type IndustryProduct struct {
+	Type     *ItemType `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Quantity int64     `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	// Chance of success, between 0 and 1.
+	Probability          float64  `protobuf:"fixed64,3,opt,name=probability" json:"probability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndustryProduct) Reset()         { *m = IndustryProduct{} }
+func (m *IndustryProduct) String() string { return proto.CompactTextString(m) }
+func (*IndustryProduct) ProtoMessage()    {}
+func (*IndustryProduct) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{47}
+}
+func (m *IndustryProduct) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndustryProduct.Unmarshal(m, b)
+}
+func (m *IndustryProduct) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndustryProduct.Marshal(b, m, deterministic)
+}
+func (dst *IndustryProduct) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndustryProduct.Merge(dst, src)
+}
+func (m *IndustryProduct) XXX_Size() int {
+	return xxx_messageInfo_IndustryProduct.Size(m)
+}
+func (m *IndustryProduct) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndustryProduct.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndustryProduct proto.InternalMessageInfo
+
+func (m *IndustryProduct) GetType() *ItemType {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *IndustryProduct) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *IndustryProduct) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+// An IndustrySkill is a skill and level required to perform an industry activity.
+type IndustrySkill struct {
+	Type                 *ItemType `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Level                int64     `protobuf:"varint,2,opt,name=level" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *IndustrySkill) Reset()         { *m = IndustrySkill{} }
+func (m *IndustrySkill) String() string { return proto.CompactTextString(m) }
+func (*IndustrySkill) ProtoMessage()    {}
+func (*IndustrySkill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{48}
+}
+func (m *IndustrySkill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndustrySkill.Unmarshal(m, b)
+}
+func (m *IndustrySkill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndustrySkill.Marshal(b, m, deterministic)
+}
+func (dst *IndustrySkill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndustrySkill.Merge(dst, src)
+}
+func (m *IndustrySkill) XXX_Size() int {
+	return xxx_messageInfo_IndustrySkill.Size(m)
+}
+func (m *IndustrySkill) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndustrySkill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndustrySkill proto.InternalMessageInfo
+
+func (m *IndustrySkill) GetType() *ItemType {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *IndustrySkill) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+// An IndustryActivity describes a single run of an industry job.
+type IndustryActivity struct {
+	ActivityId int64 `protobuf:"varint,1,opt,name=activity_id,json=activityId" json:"activity_id,omitempty"`
+	// Time a single run takes, in seconds.
+	Time                 int64              `protobuf:"varint,2,opt,name=time" json:"time,omitempty"`
+	Material             []*Material        `protobuf:"bytes,3,rep,name=material" json:"material,omitempty"`
+	Product              []*IndustryProduct `protobuf:"bytes,4,rep,name=product" json:"product,omitempty"`
+	Skill                []*IndustrySkill   `protobuf:"bytes,5,rep,name=skill" json:"skill,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *IndustryActivity) Reset()         { *m = IndustryActivity{} }
+func (m *IndustryActivity) String() string { return proto.CompactTextString(m) }
+func (*IndustryActivity) ProtoMessage()    {}
+func (*IndustryActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{49}
+}
+func (m *IndustryActivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndustryActivity.Unmarshal(m, b)
+}
+func (m *IndustryActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndustryActivity.Marshal(b, m, deterministic)
+}
+func (dst *IndustryActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndustryActivity.Merge(dst, src)
+}
+func (m *IndustryActivity) XXX_Size() int {
+	return xxx_messageInfo_IndustryActivity.Size(m)
+}
+func (m *IndustryActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndustryActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndustryActivity proto.InternalMessageInfo
+
+func (m *IndustryActivity) GetActivityId() int64 {
+	if m != nil {
+		return m.ActivityId
+	}
+	return 0
+}
+
+func (m *IndustryActivity) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *IndustryActivity) GetMaterial() []*Material {
+	if m != nil {
+		return m.Material
+	}
+	return nil
+}
+
+func (m *IndustryActivity) GetProduct() []*IndustryProduct {
+	if m != nil {
+		return m.Product
+	}
+	return nil
+}
+
+func (m *IndustryActivity) GetSkill() []*IndustrySkill {
+	if m != nil {
+		return m.Skill
+	}
+	return nil
+}
+
+// An IndustryBlueprint describes the industry activities that can be performed using a blueprint.
+type IndustryBlueprint struct {
+	Type                 *ItemType           `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	MaxProductionLimit   int64               `protobuf:"varint,2,opt,name=max_production_limit,json=maxProductionLimit" json:"max_production_limit,omitempty"`
+	Activity             []*IndustryActivity `protobuf:"bytes,3,rep,name=activity" json:"activity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *IndustryBlueprint) Reset()         { *m = IndustryBlueprint{} }
+func (m *IndustryBlueprint) String() string { return proto.CompactTextString(m) }
+func (*IndustryBlueprint) ProtoMessage()    {}
+func (*IndustryBlueprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{50}
+}
+func (m *IndustryBlueprint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndustryBlueprint.Unmarshal(m, b)
+}
+func (m *IndustryBlueprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndustryBlueprint.Marshal(b, m, deterministic)
+}
+func (dst *IndustryBlueprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndustryBlueprint.Merge(dst, src)
+}
+func (m *IndustryBlueprint) XXX_Size() int {
+	return xxx_messageInfo_IndustryBlueprint.Size(m)
+}
+func (m *IndustryBlueprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndustryBlueprint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndustryBlueprint proto.InternalMessageInfo
+
+func (m *IndustryBlueprint) GetType() *ItemType {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *IndustryBlueprint) GetMaxProductionLimit() int64 {
+	if m != nil {
+		return m.MaxProductionLimit
+	}
+	return 0
+}
+
+func (m *IndustryBlueprint) GetActivity() []*IndustryActivity {
+	if m != nil {
+		return m.Activity
+	}
+	return nil
+}
+
+type GetIndustryBlueprintRequest struct {
+	TypeId               int64    `protobuf:"varint,1,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIndustryBlueprintRequest) Reset()         { *m = GetIndustryBlueprintRequest{} }
+func (m *GetIndustryBlueprintRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndustryBlueprintRequest) ProtoMessage()    {}
+func (*GetIndustryBlueprintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{51}
+}
+func (m *GetIndustryBlueprintRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndustryBlueprintRequest.Unmarshal(m, b)
+}
+func (m *GetIndustryBlueprintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndustryBlueprintRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetIndustryBlueprintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndustryBlueprintRequest.Merge(dst, src)
+}
+func (m *GetIndustryBlueprintRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndustryBlueprintRequest.Size(m)
+}
+func (m *GetIndustryBlueprintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndustryBlueprintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndustryBlueprintRequest proto.InternalMessageInfo
+
+func (m *GetIndustryBlueprintRequest) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+type GetIndustryBlueprintResponse struct {
+	Result               *Result            `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Blueprint            *IndustryBlueprint `protobuf:"bytes,2,opt,name=blueprint" json:"blueprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetIndustryBlueprintResponse) Reset()         { *m = GetIndustryBlueprintResponse{} }
+func (m *GetIndustryBlueprintResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndustryBlueprintResponse) ProtoMessage()    {}
+func (*GetIndustryBlueprintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{52}
+}
+func (m *GetIndustryBlueprintResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndustryBlueprintResponse.Unmarshal(m, b)
+}
+func (m *GetIndustryBlueprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndustryBlueprintResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetIndustryBlueprintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndustryBlueprintResponse.Merge(dst, src)
+}
+func (m *GetIndustryBlueprintResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndustryBlueprintResponse.Size(m)
+}
+func (m *GetIndustryBlueprintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndustryBlueprintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndustryBlueprintResponse proto.InternalMessageInfo
+
+func (m *GetIndustryBlueprintResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetIndustryBlueprintResponse) GetBlueprint() *IndustryBlueprint {
+	if m != nil {
+		return m.Blueprint
+	}
+	return nil
+}
+
+type GetIndustryBlueprintByProductRequest struct {
+	ProductTypeId        int64    `protobuf:"varint,1,opt,name=product_type_id,json=productTypeId" json:"product_type_id,omitempty"`
+	ActivityId           int64    `protobuf:"varint,2,opt,name=activity_id,json=activityId" json:"activity_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIndustryBlueprintByProductRequest) Reset()         { *m = GetIndustryBlueprintByProductRequest{} }
+func (m *GetIndustryBlueprintByProductRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndustryBlueprintByProductRequest) ProtoMessage()    {}
+func (*GetIndustryBlueprintByProductRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{53}
+}
+func (m *GetIndustryBlueprintByProductRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndustryBlueprintByProductRequest.Unmarshal(m, b)
+}
+func (m *GetIndustryBlueprintByProductRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndustryBlueprintByProductRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetIndustryBlueprintByProductRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndustryBlueprintByProductRequest.Merge(dst, src)
+}
+func (m *GetIndustryBlueprintByProductRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndustryBlueprintByProductRequest.Size(m)
+}
+func (m *GetIndustryBlueprintByProductRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndustryBlueprintByProductRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndustryBlueprintByProductRequest proto.InternalMessageInfo
+
+func (m *GetIndustryBlueprintByProductRequest) GetProductTypeId() int64 {
+	if m != nil {
+		return m.ProductTypeId
+	}
+	return 0
+}
+
+func (m *GetIndustryBlueprintByProductRequest) GetActivityId() int64 {
+	if m != nil {
+		return m.ActivityId
+	}
+	return 0
+}
+
+type GetIndustryBlueprintByProductResponse struct {
+	Result               *Result            `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Blueprint            *IndustryBlueprint `protobuf:"bytes,2,opt,name=blueprint" json:"blueprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetIndustryBlueprintByProductResponse) Reset()         { *m = GetIndustryBlueprintByProductResponse{} }
+func (m *GetIndustryBlueprintByProductResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndustryBlueprintByProductResponse) ProtoMessage()    {}
+func (*GetIndustryBlueprintByProductResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{54}
+}
+func (m *GetIndustryBlueprintByProductResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndustryBlueprintByProductResponse.Unmarshal(m, b)
+}
+func (m *GetIndustryBlueprintByProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndustryBlueprintByProductResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetIndustryBlueprintByProductResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndustryBlueprintByProductResponse.Merge(dst, src)
+}
+func (m *GetIndustryBlueprintByProductResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndustryBlueprintByProductResponse.Size(m)
+}
+func (m *GetIndustryBlueprintByProductResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndustryBlueprintByProductResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndustryBlueprintByProductResponse proto.InternalMessageInfo
+
+func (m *GetIndustryBlueprintByProductResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetIndustryBlueprintByProductResponse) GetBlueprint() *IndustryBlueprint {
+	if m != nil {
+		return m.Blueprint
+	}
+	return nil
+}
+
+// A DogmaAttribute is a dogma attribute and, for a specific type, its value.
+type DogmaAttribute struct {
+	AttributeId  int64   `protobuf:"varint,1,opt,name=attribute_id,json=attributeId" json:"attribute_id,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	DisplayName  string  `protobuf:"bytes,3,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Description  string  `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	UnitId       int64   `protobuf:"varint,5,opt,name=unit_id,json=unitId" json:"unit_id,omitempty"`
+	DefaultValue float64 `protobuf:"fixed64,6,opt,name=default_value,json=defaultValue" json:"default_value,omitempty"`
+	HighIsGood   bool    `protobuf:"varint,7,opt,name=high_is_good,json=highIsGood" json:"high_is_good,omitempty"`
+	Stackable    bool    `protobuf:"varint,8,opt,name=stackable" json:"stackable,omitempty"`
+	Published    bool    `protobuf:"varint,9,opt,name=published" json:"published,omitempty"`
+	// Value of the attribute for a specific type.
+	Value                float64  `protobuf:"fixed64,10,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DogmaAttribute) Reset()         { *m = DogmaAttribute{} }
+func (m *DogmaAttribute) String() string { return proto.CompactTextString(m) }
+func (*DogmaAttribute) ProtoMessage()    {}
+func (*DogmaAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{55}
+}
+func (m *DogmaAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DogmaAttribute.Unmarshal(m, b)
+}
+func (m *DogmaAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DogmaAttribute.Marshal(b, m, deterministic)
+}
+func (dst *DogmaAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DogmaAttribute.Merge(dst, src)
+}
+func (m *DogmaAttribute) XXX_Size() int {
+	return xxx_messageInfo_DogmaAttribute.Size(m)
+}
+func (m *DogmaAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DogmaAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DogmaAttribute proto.InternalMessageInfo
+
+func (m *DogmaAttribute) GetAttributeId() int64 {
+	if m != nil {
+		return m.AttributeId
+	}
+	return 0
+}
+
+func (m *DogmaAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DogmaAttribute) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *DogmaAttribute) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DogmaAttribute) GetUnitId() int64 {
+	if m != nil {
+		return m.UnitId
+	}
+	return 0
+}
+
+func (m *DogmaAttribute) GetDefaultValue() float64 {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return 0
+}
+
+func (m *DogmaAttribute) GetHighIsGood() bool {
+	if m != nil {
+		return m.HighIsGood
+	}
+	return false
+}
+
+func (m *DogmaAttribute) GetStackable() bool {
+	if m != nil {
+		return m.Stackable
+	}
+	return false
+}
+
+func (m *DogmaAttribute) GetPublished() bool {
+	if m != nil {
+		return m.Published
+	}
+	return false
+}
+
+func (m *DogmaAttribute) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// A DogmaEffect is a dogma effect of a specific type.
+type DogmaEffect struct {
+	EffectId             int64    `protobuf:"varint,1,opt,name=effect_id,json=effectId" json:"effect_id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	DisplayName          string   `protobuf:"bytes,3,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Category             int64    `protobuf:"varint,5,opt,name=category" json:"category,omitempty"`
+	IsDefault            bool     `protobuf:"varint,6,opt,name=is_default,json=isDefault" json:"is_default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DogmaEffect) Reset()         { *m = DogmaEffect{} }
+func (m *DogmaEffect) String() string { return proto.CompactTextString(m) }
+func (*DogmaEffect) ProtoMessage()    {}
+func (*DogmaEffect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{56}
+}
+func (m *DogmaEffect) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DogmaEffect.Unmarshal(m, b)
+}
+func (m *DogmaEffect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DogmaEffect.Marshal(b, m, deterministic)
+}
+func (dst *DogmaEffect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DogmaEffect.Merge(dst, src)
+}
+func (m *DogmaEffect) XXX_Size() int {
+	return xxx_messageInfo_DogmaEffect.Size(m)
+}
+func (m *DogmaEffect) XXX_DiscardUnknown() {
+	xxx_messageInfo_DogmaEffect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DogmaEffect proto.InternalMessageInfo
+
+func (m *DogmaEffect) GetEffectId() int64 {
+	if m != nil {
+		return m.EffectId
+	}
+	return 0
+}
+
+func (m *DogmaEffect) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DogmaEffect) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *DogmaEffect) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DogmaEffect) GetCategory() int64 {
+	if m != nil {
+		return m.Category
+	}
+	return 0
+}
+
+func (m *DogmaEffect) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
+// TypeDogma contains the dogma attributes and effects of a type.
+type TypeDogma struct {
+	TypeId               int64             `protobuf:"varint,1,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	Attribute            []*DogmaAttribute `protobuf:"bytes,2,rep,name=attribute" json:"attribute,omitempty"`
+	Effect               []*DogmaEffect    `protobuf:"bytes,3,rep,name=effect" json:"effect,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TypeDogma) Reset()         { *m = TypeDogma{} }
+func (m *TypeDogma) String() string { return proto.CompactTextString(m) }
+func (*TypeDogma) ProtoMessage()    {}
+func (*TypeDogma) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{57}
+}
+func (m *TypeDogma) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypeDogma.Unmarshal(m, b)
+}
+func (m *TypeDogma) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypeDogma.Marshal(b, m, deterministic)
+}
+func (dst *TypeDogma) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeDogma.Merge(dst, src)
+}
+func (m *TypeDogma) XXX_Size() int {
+	return xxx_messageInfo_TypeDogma.Size(m)
+}
+func (m *TypeDogma) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeDogma.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypeDogma proto.InternalMessageInfo
+
+func (m *TypeDogma) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *TypeDogma) GetAttribute() []*DogmaAttribute {
+	if m != nil {
+		return m.Attribute
+	}
+	return nil
+}
+
+func (m *TypeDogma) GetEffect() []*DogmaEffect {
+	if m != nil {
+		return m.Effect
+	}
+	return nil
+}
+
+type GetTypeDogmaRequest struct {
+	TypeId               []int64  `protobuf:"varint,1,rep,packed,name=type_id,json=typeId" json:"type_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTypeDogmaRequest) Reset()         { *m = GetTypeDogmaRequest{} }
+func (m *GetTypeDogmaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTypeDogmaRequest) ProtoMessage()    {}
+func (*GetTypeDogmaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{58}
+}
+func (m *GetTypeDogmaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTypeDogmaRequest.Unmarshal(m, b)
+}
+func (m *GetTypeDogmaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTypeDogmaRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTypeDogmaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTypeDogmaRequest.Merge(dst, src)
+}
+func (m *GetTypeDogmaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTypeDogmaRequest.Size(m)
+}
+func (m *GetTypeDogmaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTypeDogmaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTypeDogmaRequest proto.InternalMessageInfo
+
+func (m *GetTypeDogmaRequest) GetTypeId() []int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return nil
+}
+
+type GetTypeDogmaResponse struct {
+	Result *Result `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	// One entry for each requested type, in the order requested.
+	Dogma                []*TypeDogma `protobuf:"bytes,2,rep,name=dogma" json:"dogma,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetTypeDogmaResponse) Reset()         { *m = GetTypeDogmaResponse{} }
+func (m *GetTypeDogmaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTypeDogmaResponse) ProtoMessage()    {}
+func (*GetTypeDogmaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{59}
+}
+func (m *GetTypeDogmaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTypeDogmaResponse.Unmarshal(m, b)
+}
+func (m *GetTypeDogmaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTypeDogmaResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTypeDogmaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTypeDogmaResponse.Merge(dst, src)
+}
+func (m *GetTypeDogmaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTypeDogmaResponse.Size(m)
+}
+func (m *GetTypeDogmaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTypeDogmaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTypeDogmaResponse proto.InternalMessageInfo
+
+func (m *GetTypeDogmaResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetTypeDogmaResponse) GetDogma() []*TypeDogma {
+	if m != nil {
+		return m.Dogma
+	}
+	return nil
+}
+
+type GetAttributeTypesRequest struct {
+	AttributeId          []int64  `protobuf:"varint,1,rep,packed,name=attribute_id,json=attributeId" json:"attribute_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAttributeTypesRequest) Reset()         { *m = GetAttributeTypesRequest{} }
+func (m *GetAttributeTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAttributeTypesRequest) ProtoMessage()    {}
+func (*GetAttributeTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{60}
+}
+func (m *GetAttributeTypesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAttributeTypesRequest.Unmarshal(m, b)
+}
+func (m *GetAttributeTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAttributeTypesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAttributeTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAttributeTypesRequest.Merge(dst, src)
+}
+func (m *GetAttributeTypesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAttributeTypesRequest.Size(m)
+}
+func (m *GetAttributeTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAttributeTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAttributeTypesRequest proto.InternalMessageInfo
+
+func (m *GetAttributeTypesRequest) GetAttributeId() []int64 {
+	if m != nil {
+		return m.AttributeId
+	}
+	return nil
+}
+
+type GetAttributeTypesResponse struct {
+	Result               *Result           `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Attribute            []*DogmaAttribute `protobuf:"bytes,2,rep,name=attribute" json:"attribute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetAttributeTypesResponse) Reset()         { *m = GetAttributeTypesResponse{} }
+func (m *GetAttributeTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAttributeTypesResponse) ProtoMessage()    {}
+func (*GetAttributeTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_evedb_7c508332a6f56819, []int{61}
+}
+func (m *GetAttributeTypesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAttributeTypesResponse.Unmarshal(m, b)
+}
+func (m *GetAttributeTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAttributeTypesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAttributeTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAttributeTypesResponse.Merge(dst, src)
+}
+func (m *GetAttributeTypesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAttributeTypesResponse.Size(m)
+}
+func (m *GetAttributeTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAttributeTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAttributeTypesResponse proto.InternalMessageInfo
+
+func (m *GetAttributeTypesResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetAttributeTypesResponse) GetAttribute() []*DogmaAttribute {
+	if m != nil {
+		return m.Attribute
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Icon)(nil), "motki.evedb.Icon")
 	proto.RegisterType((*Race)(nil), "motki.evedb.Race")
@@ -2220,6 +3375,28 @@ func init() {
 	proto.RegisterType((*GetMaterialSheetResponse)(nil), "motki.evedb.GetMaterialSheetResponse")
 	proto.RegisterType((*GetStationRequest)(nil), "motki.evedb.GetStationRequest")
 	proto.RegisterType((*GetStationResponse)(nil), "motki.evedb.GetStationResponse")
+	proto.RegisterType((*GetVersionRequest)(nil), "motki.evedb.GetVersionRequest")
+	proto.RegisterType((*GetVersionResponse)(nil), "motki.evedb.GetVersionResponse")
+	proto.RegisterType((*Route)(nil), "motki.evedb.Route")
+	proto.RegisterType((*GetRouteRequest)(nil), "motki.evedb.GetRouteRequest")
+	proto.RegisterType((*GetRouteResponse)(nil), "motki.evedb.GetRouteResponse")
+	proto.RegisterType((*GetJumpsRequest)(nil), "motki.evedb.GetJumpsRequest")
+	proto.RegisterType((*GetJumpsResponse)(nil), "motki.evedb.GetJumpsResponse")
+	proto.RegisterType((*IndustryProduct)(nil), "motki.evedb.IndustryProduct")
+	proto.RegisterType((*IndustrySkill)(nil), "motki.evedb.IndustrySkill")
+	proto.RegisterType((*IndustryActivity)(nil), "motki.evedb.IndustryActivity")
+	proto.RegisterType((*IndustryBlueprint)(nil), "motki.evedb.IndustryBlueprint")
+	proto.RegisterType((*GetIndustryBlueprintRequest)(nil), "motki.evedb.GetIndustryBlueprintRequest")
+	proto.RegisterType((*GetIndustryBlueprintResponse)(nil), "motki.evedb.GetIndustryBlueprintResponse")
+	proto.RegisterType((*GetIndustryBlueprintByProductRequest)(nil), "motki.evedb.GetIndustryBlueprintByProductRequest")
+	proto.RegisterType((*GetIndustryBlueprintByProductResponse)(nil), "motki.evedb.GetIndustryBlueprintByProductResponse")
+	proto.RegisterType((*DogmaAttribute)(nil), "motki.evedb.DogmaAttribute")
+	proto.RegisterType((*DogmaEffect)(nil), "motki.evedb.DogmaEffect")
+	proto.RegisterType((*TypeDogma)(nil), "motki.evedb.TypeDogma")
+	proto.RegisterType((*GetTypeDogmaRequest)(nil), "motki.evedb.GetTypeDogmaRequest")
+	proto.RegisterType((*GetTypeDogmaResponse)(nil), "motki.evedb.GetTypeDogmaResponse")
+	proto.RegisterType((*GetAttributeTypesRequest)(nil), "motki.evedb.GetAttributeTypesRequest")
+	proto.RegisterType((*GetAttributeTypesResponse)(nil), "motki.evedb.GetAttributeTypesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2234,6 +3411,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type EveDBServiceClient interface {
+	// GetVersion returns an identifier for the currently installed static dump.
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	// GetRegion gets a specific region.
 	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
 	// GetRegions returns a list of all regions.
@@ -2262,6 +3441,18 @@ type EveDBServiceClient interface {
 	QueryItemTypes(ctx context.Context, in *QueryItemTypesRequest, opts ...grpc.CallOption) (*QueryItemTypesResponse, error)
 	// QueryItemTypeDetails returns detailed information for types matching the input query.
 	QueryItemTypeDetails(ctx context.Context, in *QueryItemTypeDetailsRequest, opts ...grpc.CallOption) (*QueryItemTypeDetailsResponse, error)
+	// GetRoute returns the route between two solar systems.
+	GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error)
+	// GetJumps returns the number of jumps in the shortest route between two solar systems.
+	GetJumps(ctx context.Context, in *GetJumpsRequest, opts ...grpc.CallOption) (*GetJumpsResponse, error)
+	// GetIndustryBlueprint gets the industry activities of a specific blueprint.
+	GetIndustryBlueprint(ctx context.Context, in *GetIndustryBlueprintRequest, opts ...grpc.CallOption) (*GetIndustryBlueprintResponse, error)
+	// GetIndustryBlueprintByProduct gets the blueprint that produces a type using an activity.
+	GetIndustryBlueprintByProduct(ctx context.Context, in *GetIndustryBlueprintByProductRequest, opts ...grpc.CallOption) (*GetIndustryBlueprintByProductResponse, error)
+	// GetTypeDogma gets the dogma attributes and effects of one or more types.
+	GetTypeDogma(ctx context.Context, in *GetTypeDogmaRequest, opts ...grpc.CallOption) (*GetTypeDogmaResponse, error)
+	// GetAttributeTypes gets one or more dogma attributes.
+	GetAttributeTypes(ctx context.Context, in *GetAttributeTypesRequest, opts ...grpc.CallOption) (*GetAttributeTypesResponse, error)
 }
 
 type eveDBServiceClient struct {
@@ -2272,6 +3463,15 @@ func NewEveDBServiceClient(cc *grpc.ClientConn) EveDBServiceClient {
 	return &eveDBServiceClient{cc}
 }
 
+func (c *eveDBServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eveDBServiceClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
 	out := new(GetRegionResponse)
 	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetRegion", in, out, opts...)
@@ -2398,8 +3598,64 @@ func (c *eveDBServiceClient) QueryItemTypeDetails(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *eveDBServiceClient) GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error) {
+	out := new(GetRouteResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eveDBServiceClient) GetJumps(ctx context.Context, in *GetJumpsRequest, opts ...grpc.CallOption) (*GetJumpsResponse, error) {
+	out := new(GetJumpsResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetJumps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eveDBServiceClient) GetIndustryBlueprint(ctx context.Context, in *GetIndustryBlueprintRequest, opts ...grpc.CallOption) (*GetIndustryBlueprintResponse, error) {
+	out := new(GetIndustryBlueprintResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetIndustryBlueprint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eveDBServiceClient) GetIndustryBlueprintByProduct(ctx context.Context, in *GetIndustryBlueprintByProductRequest, opts ...grpc.CallOption) (*GetIndustryBlueprintByProductResponse, error) {
+	out := new(GetIndustryBlueprintByProductResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetIndustryBlueprintByProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eveDBServiceClient) GetTypeDogma(ctx context.Context, in *GetTypeDogmaRequest, opts ...grpc.CallOption) (*GetTypeDogmaResponse, error) {
+	out := new(GetTypeDogmaResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetTypeDogma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eveDBServiceClient) GetAttributeTypes(ctx context.Context, in *GetAttributeTypesRequest, opts ...grpc.CallOption) (*GetAttributeTypesResponse, error) {
+	out := new(GetAttributeTypesResponse)
+	err := c.cc.Invoke(ctx, "/motki.evedb.EveDBService/GetAttributeTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EveDBServiceServer is the server API for EveDBService service.
 type EveDBServiceServer interface {
+	// GetVersion returns an identifier for the currently installed static dump.
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	// GetRegion gets a specific region.
 	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
 	// GetRegions returns a list of all regions.
@@ -2428,12 +3684,42 @@ type EveDBServiceServer interface {
 	QueryItemTypes(context.Context, *QueryItemTypesRequest) (*QueryItemTypesResponse, error)
 	// QueryItemTypeDetails returns detailed information for types matching the input query.
 	QueryItemTypeDetails(context.Context, *QueryItemTypeDetailsRequest) (*QueryItemTypeDetailsResponse, error)
+	// GetRoute returns the route between two solar systems.
+	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
+	// GetJumps returns the number of jumps in the shortest route between two solar systems.
+	GetJumps(context.Context, *GetJumpsRequest) (*GetJumpsResponse, error)
+	// GetIndustryBlueprint gets the industry activities of a specific blueprint.
+	GetIndustryBlueprint(context.Context, *GetIndustryBlueprintRequest) (*GetIndustryBlueprintResponse, error)
+	// GetIndustryBlueprintByProduct gets the blueprint that produces a type using an activity.
+	GetIndustryBlueprintByProduct(context.Context, *GetIndustryBlueprintByProductRequest) (*GetIndustryBlueprintByProductResponse, error)
+	// GetTypeDogma gets the dogma attributes and effects of one or more types.
+	GetTypeDogma(context.Context, *GetTypeDogmaRequest) (*GetTypeDogmaResponse, error)
+	// GetAttributeTypes gets one or more dogma attributes.
+	GetAttributeTypes(context.Context, *GetAttributeTypesRequest) (*GetAttributeTypesResponse, error)
 }
 
 func RegisterEveDBServiceServer(s *grpc.Server, srv EveDBServiceServer) {
 	s.RegisterService(&_EveDBService_serviceDesc, srv)
 }
 
+func _EveDBService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EveDBService_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRegionRequest)
 	if err := dec(in); err != nil {
@@ -2686,10 +3972,122 @@ func _EveDBService_QueryItemTypeDetails_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EveDBService_GetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetRoute(ctx, req.(*GetRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EveDBService_GetJumps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJumpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetJumps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetJumps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetJumps(ctx, req.(*GetJumpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EveDBService_GetIndustryBlueprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndustryBlueprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetIndustryBlueprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetIndustryBlueprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetIndustryBlueprint(ctx, req.(*GetIndustryBlueprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EveDBService_GetIndustryBlueprintByProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndustryBlueprintByProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetIndustryBlueprintByProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetIndustryBlueprintByProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetIndustryBlueprintByProduct(ctx, req.(*GetIndustryBlueprintByProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EveDBService_GetTypeDogma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypeDogmaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetTypeDogma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetTypeDogma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetTypeDogma(ctx, req.(*GetTypeDogmaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EveDBService_GetAttributeTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EveDBServiceServer).GetAttributeTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.evedb.EveDBService/GetAttributeTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EveDBServiceServer).GetAttributeTypes(ctx, req.(*GetAttributeTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _EveDBService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "motki.evedb.EveDBService",
 	HandlerType: (*EveDBServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersion",
+			Handler:    _EveDBService_GetVersion_Handler,
+		},
 		{
 			MethodName: "GetRegion",
 			Handler:    _EveDBService_GetRegion_Handler,
@@ -2746,115 +4144,189 @@ var _EveDBService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryItemTypeDetails",
 			Handler:    _EveDBService_QueryItemTypeDetails_Handler,
 		},
+		{
+			MethodName: "GetRoute",
+			Handler:    _EveDBService_GetRoute_Handler,
+		},
+		{
+			MethodName: "GetJumps",
+			Handler:    _EveDBService_GetJumps_Handler,
+		},
+		{
+			MethodName: "GetIndustryBlueprint",
+			Handler:    _EveDBService_GetIndustryBlueprint_Handler,
+		},
+		{
+			MethodName: "GetIndustryBlueprintByProduct",
+			Handler:    _EveDBService_GetIndustryBlueprintByProduct_Handler,
+		},
+		{
+			MethodName: "GetTypeDogma",
+			Handler:    _EveDBService_GetTypeDogma_Handler,
+		},
+		{
+			MethodName: "GetAttributeTypes",
+			Handler:    _EveDBService_GetAttributeTypes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evedb.proto",
 }
 
-func init() { proto.RegisterFile("evedb.proto", fileDescriptor_evedb_b05ddaeec1c8004d) }
-
-var fileDescriptor_evedb_b05ddaeec1c8004d = []byte{
-	// 1621 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0xdb, 0x46,
-	0x12, 0x2e, 0x88, 0x14, 0x49, 0x34, 0x44, 0xfd, 0x8c, 0x65, 0x9b, 0xa6, 0x6c, 0x8b, 0x1e, 0x5b,
-	0x2e, 0x69, 0x7f, 0xe4, 0x5a, 0x79, 0xb7, 0x76, 0xf7, 0xb6, 0xeb, 0x28, 0x51, 0x31, 0x55, 0x76,
-	0xd9, 0x90, 0x73, 0x70, 0x0e, 0x61, 0x20, 0x60, 0x22, 0xa1, 0x44, 0x12, 0x10, 0x30, 0x94, 0x43,
-	0x5f, 0xf3, 0x06, 0xb9, 0xa6, 0xf2, 0x16, 0x39, 0xe5, 0x69, 0xfc, 0x08, 0x79, 0x84, 0xd4, 0xf4,
-	0x0c, 0x7e, 0x06, 0x00, 0x49, 0x53, 0xc9, 0x49, 0x9c, 0xee, 0x9e, 0x6f, 0xbe, 0xfe, 0x41, 0xf7,
-	0x8c, 0xc0, 0x62, 0xd7, 0xcc, 0x3b, 0x3b, 0x0c, 0xa3, 0x80, 0x07, 0xc4, 0x1a, 0x05, 0xfc, 0xd2,
-	0x3f, 0x44, 0x51, 0x57, 0x2d, 0x50, 0x43, 0xbf, 0x81, 0x7a, 0xdf, 0x0d, 0xc6, 0xe4, 0x2e, 0x34,
-	0x7d, 0x37, 0x18, 0x0f, 0x7c, 0xaf, 0x63, 0xf4, 0x8c, 0xfd, 0x9a, 0xdd, 0x10, 0xcb, 0xbe, 0x47,
-	0x76, 0xc0, 0xf4, 0x47, 0xce, 0x39, 0x1b, 0x4c, 0xa2, 0x61, 0x67, 0xa5, 0x67, 0xec, 0x9b, 0x76,
-	0x0b, 0x05, 0x5f, 0x45, 0x43, 0xd2, 0x03, 0xcb, 0x63, 0xb1, 0x1b, 0xf9, 0x21, 0xf7, 0x83, 0x71,
-	0xa7, 0x86, 0xea, 0xbc, 0x88, 0xfe, 0x64, 0x40, 0xdd, 0x76, 0x5c, 0x26, 0x0e, 0x88, 0x1c, 0x97,
-	0xe5, 0x0e, 0x10, 0xcb, 0xbe, 0x47, 0x08, 0xd4, 0xc7, 0xce, 0x88, 0x29, 0x6c, 0xfc, 0xbd, 0x18,
-	0x97, 0x3c, 0x00, 0x88, 0x2f, 0x82, 0x88, 0x0f, 0x84, 0xb0, 0x53, 0x47, 0x03, 0x13, 0x25, 0xc7,
-	0x2c, 0x76, 0xc9, 0x1e, 0xd4, 0x05, 0xff, 0xce, 0x6a, 0xcf, 0xd8, 0xb7, 0x8e, 0xb6, 0x0e, 0x73,
-	0xfe, 0x1f, 0x0a, 0x7f, 0x6d, 0x54, 0xd3, 0x8f, 0x2b, 0xd0, 0xfa, 0xff, 0xd8, 0x65, 0x31, 0x8f,
-	0xa6, 0x64, 0x17, 0x2c, 0x47, 0xfd, 0xce, 0x58, 0x42, 0x22, 0xba, 0x31, 0xd3, 0x47, 0xb0, 0x76,
-	0x36, 0x0c, 0x02, 0x6f, 0xe8, 0x8f, 0xd1, 0xfb, 0x3a, 0xe2, 0x5a, 0xa9, 0xac, 0xef, 0x91, 0x87,
-	0x00, 0x21, 0x8b, 0x5c, 0x26, 0x31, 0x56, 0xe5, 0xc1, 0x99, 0x84, 0xdc, 0x07, 0xf3, 0xbd, 0x3f,
-	0x1c, 0x86, 0xc1, 0x7b, 0x16, 0x75, 0x1a, 0xa8, 0xce, 0x04, 0xa4, 0x0b, 0x2d, 0xf7, 0xc2, 0x89,
-	0xfc, 0x78, 0xe4, 0x74, 0x9a, 0xa8, 0x4c, 0xd7, 0xe4, 0x0e, 0x34, 0x46, 0x6c, 0x14, 0x44, 0xd3,
-	0x4e, 0x4b, 0x06, 0x5d, 0xae, 0x08, 0x85, 0x35, 0x7f, 0xcc, 0xd9, 0x70, 0xe8, 0x9f, 0xb3, 0xb1,
-	0xcb, 0x3a, 0x26, 0x6a, 0x35, 0x59, 0x21, 0xc4, 0x30, 0x2b, 0xc4, 0xd6, 0xfc, 0x10, 0xff, 0x52,
-	0x07, 0xf3, 0x45, 0xe2, 0x6b, 0x29, 0x18, 0x46, 0x39, 0x18, 0x55, 0x51, 0xce, 0x15, 0x4f, 0x4d,
-	0x2b, 0x9e, 0x42, 0xf8, 0xeb, 0xe5, 0xf0, 0xef, 0x80, 0x39, 0x72, 0x86, 0x4c, 0x3a, 0xb1, 0x2a,
-	0xeb, 0x57, 0x08, 0xd0, 0x87, 0x5d, 0xb0, 0xbe, 0x63, 0x99, 0xba, 0x81, 0x6a, 0x90, 0x22, 0x34,
-	0xe8, 0xc1, 0x5a, 0x7c, 0xe1, 0x87, 0x03, 0x3e, 0x0d, 0xf1, 0x74, 0x19, 0x5f, 0x10, 0xb2, 0xb7,
-	0xd3, 0x50, 0x30, 0xd8, 0x83, 0x75, 0x37, 0x88, 0xc2, 0x20, 0x72, 0xc4, 0x71, 0xc2, 0x46, 0x46,
-	0xba, 0x9d, 0x93, 0x96, 0x52, 0x6c, 0xce, 0x4f, 0x31, 0xcc, 0x4b, 0xb1, 0x35, 0x33, 0xc5, 0x6b,
-	0x73, 0x53, 0xdc, 0x5e, 0x98, 0xe2, 0xf5, 0x62, 0x8a, 0x9f, 0xc2, 0x86, 0x54, 0x67, 0x21, 0xda,
-	0x40, 0x9b, 0x36, 0x8a, 0x5f, 0x26, 0x51, 0xfa, 0x0b, 0x6c, 0x49, 0xbb, 0x7c, 0x30, 0x37, 0xd1,
-	0x52, 0x02, 0x7c, 0x91, 0x45, 0x34, 0x29, 0x9b, 0xad, 0xf9, 0x65, 0xf3, 0xb3, 0x01, 0x8d, 0xd3,
-	0x69, 0xcc, 0xd9, 0x48, 0x64, 0x30, 0xc6, 0x5f, 0x59, 0xc1, 0xb4, 0xa4, 0x60, 0x46, 0xb5, 0xec,
-	0x80, 0x19, 0xb1, 0x73, 0x95, 0x0d, 0x59, 0x2f, 0x2d, 0x29, 0xe8, 0x7b, 0xe4, 0x00, 0x36, 0xdd,
-	0x60, 0x1c, 0x8b, 0x20, 0xa4, 0x19, 0x93, 0x9f, 0xe4, 0x86, 0x26, 0xef, 0x7b, 0x22, 0xea, 0x31,
-	0x73, 0x27, 0x91, 0xcf, 0xa7, 0x58, 0x39, 0x86, 0x9d, 0xae, 0xe9, 0x25, 0xb4, 0x3f, 0xcb, 0x9b,
-	0x57, 0xe2, 0x1a, 0xd5, 0xb8, 0xcb, 0x72, 0xa6, 0xff, 0x85, 0x86, 0x8d, 0xbf, 0x75, 0x33, 0xa3,
-	0xe0, 0x5a, 0x05, 0x2e, 0xfd, 0xcd, 0x80, 0xe6, 0x29, 0x77, 0xd2, 0x9e, 0xc9, 0x0b, 0xe4, 0x4c,
-	0x25, 0xe9, 0x7b, 0x98, 0x6d, 0xa5, 0x4e, 0xca, 0x7d, 0x45, 0x96, 0xb2, 0x12, 0xcf, 0xac, 0xf8,
-	0x5a, 0x55, 0xc5, 0x6b, 0x69, 0xab, 0x17, 0xd2, 0x56, 0x15, 0xad, 0xd5, 0xea, 0x68, 0x69, 0x2e,
-	0x37, 0x66, 0xb8, 0xdc, 0xcc, 0xb9, 0xfc, 0x0e, 0x5a, 0x7d, 0xce, 0x46, 0x82, 0xad, 0x68, 0x1c,
-	0x89, 0x2f, 0x6a, 0xea, 0x70, 0xe9, 0xc4, 0x8d, 0x7a, 0x39, 0xfd, 0x58, 0x83, 0xf5, 0x04, 0xfb,
-	0x98, 0x71, 0xc7, 0x1f, 0xfe, 0xc9, 0x27, 0x90, 0x7b, 0xd0, 0x3a, 0x8f, 0x82, 0x49, 0x98, 0x05,
-	0xad, 0x89, 0xeb, 0xbe, 0x27, 0xd2, 0x27, 0x55, 0x08, 0x2b, 0x5b, 0x99, 0x89, 0x92, 0x57, 0x02,
-	0x7b, 0x17, 0x2c, 0xd7, 0xe1, 0xec, 0x3c, 0x90, 0xe3, 0x4b, 0x46, 0x0a, 0x12, 0x51, 0xdf, 0x23,
-	0x8f, 0xa1, 0x9d, 0x1a, 0xe4, 0x82, 0xb6, 0x96, 0x08, 0x11, 0x85, 0x40, 0x7d, 0xe4, 0xc4, 0x31,
-	0x36, 0x31, 0xc3, 0xc6, 0xdf, 0xa2, 0xc3, 0x5c, 0x07, 0xc3, 0xc9, 0x48, 0x8e, 0x09, 0xc3, 0x56,
-	0x2b, 0xec, 0x4a, 0x4e, 0xe8, 0xb8, 0xe2, 0xfb, 0x00, 0xf9, 0x7d, 0x24, 0x6b, 0xd1, 0xe8, 0xc3,
-	0x20, 0xc2, 0xd4, 0xc6, 0xfe, 0x07, 0xa6, 0xba, 0x96, 0xa5, 0x64, 0xa7, 0xfe, 0x07, 0x6c, 0x3e,
-	0x67, 0x4e, 0xcc, 0x06, 0x61, 0xe4, 0xbb, 0x0c, 0x9b, 0x97, 0x61, 0x9b, 0x42, 0xf2, 0x5a, 0x08,
-	0xc8, 0x13, 0x58, 0x0f, 0x9d, 0x88, 0x8d, 0x79, 0x5a, 0x8d, 0xaa, 0x83, 0x49, 0xa9, 0x2a, 0x46,
-	0x1c, 0x28, 0x13, 0x16, 0x46, 0xfe, 0x98, 0x0b, 0x9b, 0xf5, 0x64, 0xa0, 0x28, 0x59, 0xdf, 0x23,
-	0x7f, 0x03, 0xe2, 0xb1, 0xc8, 0xbf, 0x76, 0xb8, 0x7f, 0xcd, 0x52, 0xb0, 0x8d, 0x5e, 0x6d, 0xbf,
-	0x66, 0x6f, 0x66, 0x1a, 0x09, 0x48, 0x7f, 0x34, 0xa0, 0xfd, 0xd2, 0xe1, 0x2c, 0xf2, 0x9d, 0xe1,
-	0xe9, 0x05, 0x63, 0x9c, 0x1c, 0x40, 0x5d, 0x6c, 0xc2, 0xf4, 0x5a, 0x47, 0xb7, 0xf5, 0x8e, 0xa5,
-	0x8a, 0xc1, 0x46, 0x13, 0xf2, 0x5c, 0x0c, 0x1b, 0xb9, 0x37, 0xee, 0xac, 0xf4, 0x6a, 0x25, 0xfb,
-	0x04, 0xd9, 0xce, 0xec, 0x30, 0x54, 0x51, 0xe0, 0x4d, 0x5c, 0x16, 0x0f, 0xae, 0xf8, 0x54, 0x7d,
-	0x4d, 0x56, 0x22, 0x7b, 0xc3, 0xa7, 0xf4, 0x0d, 0xb4, 0x92, 0x9d, 0xcb, 0xd0, 0xe9, 0x42, 0xeb,
-	0x6a, 0xe2, 0x8c, 0xb9, 0x48, 0x90, 0xfc, 0x94, 0xd3, 0x35, 0x7d, 0x06, 0x9b, 0x27, 0x8c, 0xcb,
-	0xb6, 0x62, 0xb3, 0xab, 0x09, 0x8b, 0xf9, 0xdc, 0xee, 0x42, 0xcf, 0x61, 0x2b, 0xb7, 0x21, 0x0e,
-	0x83, 0x71, 0xcc, 0xc8, 0x1e, 0x34, 0x22, 0x16, 0x4f, 0x86, 0x5c, 0xd1, 0x69, 0x2b, 0x3a, 0x36,
-	0x0a, 0x6d, 0xa5, 0x24, 0x7f, 0x15, 0x66, 0x62, 0x23, 0xd2, 0xb0, 0x8e, 0x6e, 0x69, 0xac, 0x15,
-	0xa6, 0x32, 0xa1, 0xb7, 0x72, 0x07, 0xc5, 0x8a, 0x1a, 0xbd, 0x00, 0x92, 0x17, 0xde, 0xfc, 0xf8,
-	0xda, 0xa2, 0xe3, 0x8f, 0xe1, 0xee, 0x09, 0xe3, 0x5a, 0x73, 0x4f, 0xe2, 0xf3, 0xe9, 0x3d, 0x9e,
-	0xfe, 0x60, 0x40, 0xa7, 0x0c, 0xb3, 0x1c, 0xed, 0xff, 0x41, 0x5b, 0x83, 0x55, 0xc1, 0xeb, 0x6a,
-	0xec, 0xf5, 0x13, 0xf4, 0x0d, 0x2a, 0xc9, 0x72, 0x8e, 0xe6, 0x92, 0x3c, 0x73, 0x9c, 0xaa, 0x24,
-	0x27, 0x1b, 0x96, 0x8e, 0xb2, 0xc4, 0xa9, 0x4c, 0xb2, 0xc2, 0x54, 0x26, 0xf4, 0x00, 0xd6, 0x45,
-	0x3e, 0x1d, 0x97, 0x25, 0xbc, 0x66, 0x3d, 0x10, 0xe8, 0x00, 0x36, 0x52, 0xd3, 0xe5, 0x18, 0xed,
-	0x41, 0x5d, 0x60, 0x28, 0x3e, 0xfa, 0x5d, 0x03, 0xf1, 0x50, 0x4d, 0xb7, 0xd2, 0x03, 0xd2, 0x72,
-	0xfb, 0x56, 0x7e, 0x1d, 0x52, 0x74, 0xd3, 0x43, 0x6b, 0xf3, 0x0e, 0xfd, 0x0f, 0xdc, 0x3a, 0x61,
-	0x3c, 0xbd, 0x19, 0x27, 0x51, 0x58, 0x7c, 0x41, 0xa6, 0x31, 0x6c, 0xeb, 0x3b, 0x97, 0xe3, 0xf7,
-	0x4f, 0x30, 0x53, 0x34, 0x15, 0x99, 0x3b, 0x1a, 0xc9, 0x0c, 0x39, 0x33, 0xa4, 0xff, 0xc2, 0xef,
-	0x2f, 0x79, 0x2b, 0x25, 0x6c, 0x17, 0x3d, 0x99, 0x68, 0x80, 0x5e, 0x66, 0xdb, 0x96, 0xa3, 0xfa,
-	0x0f, 0x68, 0x25, 0x58, 0x8a, 0xa9, 0xde, 0xee, 0x52, 0xdc, 0xd4, 0x8c, 0xfe, 0x1d, 0x79, 0xa6,
-	0x7d, 0x30, 0xab, 0xad, 0xca, 0x21, 0x4d, 0xcf, 0x91, 0x5f, 0x66, 0xbe, 0x1c, 0xbf, 0xa4, 0x15,
-	0xaf, 0x2c, 0x6c, 0xc5, 0xf4, 0x39, 0xb6, 0x03, 0xfd, 0xee, 0xb0, 0x90, 0x5d, 0x0c, 0xf7, 0x2a,
-	0x36, 0x2d, 0xc7, 0xf1, 0x99, 0xc6, 0x71, 0xa7, 0x92, 0xa3, 0x42, 0x96, 0x4c, 0x5f, 0xc1, 0xed,
-	0x37, 0x13, 0x16, 0x4d, 0x13, 0x65, 0xf2, 0x4d, 0x90, 0x6d, 0x58, 0xbd, 0x12, 0x0a, 0x3c, 0xcf,
-	0xb4, 0xe5, 0xa2, 0x78, 0xed, 0x58, 0xc1, 0xb1, 0x9a, 0xbb, 0x76, 0xd0, 0x21, 0xdc, 0x29, 0xe2,
-	0x2d, 0xdb, 0x57, 0x56, 0x05, 0xb1, 0xea, 0x81, 0x9a, 0x86, 0x59, 0xda, 0xd0, 0xb7, 0xb0, 0xa3,
-	0x9d, 0x26, 0x5d, 0xfb, 0xa3, 0x3e, 0x7c, 0x0f, 0xf7, 0xab, 0x51, 0x97, 0xad, 0x67, 0xcd, 0x93,
-	0xb9, 0xc9, 0x50, 0xfe, 0x1c, 0xe1, 0x34, 0xd2, 0x2e, 0x24, 0x0b, 0xcb, 0xe6, 0x03, 0xd6, 0x5a,
-	0x61, 0xcf, 0x72, 0x4c, 0xff, 0x8d, 0x17, 0x99, 0x41, 0x2c, 0xf6, 0x56, 0x8e, 0x1d, 0x1d, 0xbd,
-	0x35, 0x72, 0x38, 0xfe, 0xa2, 0x47, 0x72, 0x80, 0x70, 0x6d, 0x6e, 0xce, 0x7f, 0x78, 0xd0, 0x4b,
-	0xfc, 0x66, 0xd3, 0x3d, 0xcb, 0x31, 0x3d, 0x84, 0xa6, 0x42, 0x52, 0x3c, 0xb7, 0xf5, 0xb1, 0xa3,
-	0x50, 0x13, 0xa3, 0xa3, 0x5f, 0x4d, 0x58, 0xfb, 0xfc, 0x9a, 0x1d, 0xbf, 0x38, 0x65, 0xd1, 0xb5,
-	0xb8, 0x67, 0x7e, 0x09, 0x66, 0x7a, 0xb3, 0x20, 0x0f, 0xb4, 0xcd, 0xc5, 0x0b, 0x52, 0xf7, 0xe1,
-	0x2c, 0xb5, 0xe2, 0xfc, 0x12, 0x20, 0xbb, 0xa5, 0x90, 0x19, 0xd6, 0x49, 0x31, 0x76, 0x77, 0x67,
-	0xea, 0x15, 0xdc, 0x00, 0xa7, 0x90, 0xfe, 0xce, 0x7c, 0x52, 0xdc, 0x54, 0x75, 0x53, 0xe9, 0xee,
-	0x2d, 0xb0, 0x52, 0x07, 0x48, 0xdf, 0xd5, 0x3b, 0xbb, 0xe4, 0xbb, 0x76, 0x6f, 0x28, 0xfb, 0x5e,
-	0xb8, 0x25, 0x48, 0xdf, 0x93, 0xb7, 0x66, 0xd9, 0x5a, 0x2b, 0x89, 0xb2, 0xef, 0xc5, 0xf4, 0x1f,
-	0x43, 0x53, 0x4d, 0x60, 0xb2, 0x53, 0x8a, 0x53, 0x76, 0x6d, 0xe8, 0xde, 0xaf, 0x56, 0x2a, 0x94,
-	0x13, 0x68, 0x25, 0x73, 0x9c, 0x54, 0x5a, 0xa6, 0xc9, 0x78, 0x30, 0x43, 0xab, 0x80, 0x4e, 0x61,
-	0x2d, 0x3f, 0x74, 0x49, 0xaf, 0x68, 0x5e, 0x9c, 0xe4, 0xdd, 0x47, 0x73, 0x2c, 0x14, 0xe8, 0x6b,
-	0xb0, 0x72, 0xd3, 0x91, 0x94, 0x62, 0x52, 0x18, 0xb7, 0xdd, 0xde, 0x6c, 0x03, 0x0d, 0x31, 0x7d,
-	0xfe, 0x96, 0x10, 0x0b, 0x83, 0xb1, 0x8c, 0x58, 0x1a, 0x85, 0x67, 0xf8, 0x41, 0x17, 0x1e, 0xbd,
-	0x7b, 0xb3, 0xb6, 0x69, 0x83, 0xad, 0xfb, 0x74, 0x91, 0x99, 0x56, 0xe7, 0xfa, 0xab, 0xab, 0x54,
-	0xe7, 0x55, 0x3d, 0xb0, 0x5c, 0xe7, 0xd5, 0x5d, 0xef, 0x1d, 0xac, 0xeb, 0x33, 0x88, 0x50, 0x6d,
-	0x63, 0xe5, 0xc0, 0xeb, 0x3e, 0x9e, 0x6b, 0xa3, 0xa0, 0x2f, 0x61, 0xbb, 0x6a, 0x34, 0x90, 0xfd,
-	0xd9, 0x9b, 0xf5, 0x99, 0xd4, 0x3d, 0xf8, 0x04, 0x4b, 0x79, 0xd8, 0x8b, 0xe6, 0xd7, 0xab, 0xf8,
-	0x6f, 0xfb, 0xb3, 0x06, 0xfe, 0x79, 0xfe, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0x10, 0x69,
-	0x07, 0xe6, 0x17, 0x00, 0x00,
+func init() { proto.RegisterFile("evedb.proto", fileDescriptor_evedb_7c508332a6f56819) }
+
+var fileDescriptor_evedb_7c508332a6f56819 = []byte{
+	// 2428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0xcb, 0x92, 0x1c, 0x47,
+	0x31, 0x7a, 0xde, 0x93, 0xb3, 0xcf, 0xd2, 0xda, 0x1a, 0xf5, 0x6a, 0xa5, 0x51, 0x59, 0xab, 0x58,
+	0x81, 0x59, 0xcb, 0x2b, 0x30, 0x28, 0x02, 0x22, 0x90, 0x58, 0xa3, 0x18, 0x07, 0x12, 0x52, 0xaf,
+	0xe5, 0x08, 0x73, 0xf0, 0xd0, 0xdb, 0x5d, 0x3b, 0x5b, 0x6c, 0xcf, 0xf4, 0xa8, 0xbb, 0x66, 0xac,
+	0x55, 0x04, 0x27, 0x13, 0x9c, 0xb8, 0xc0, 0x95, 0xe0, 0x0b, 0xb8, 0x72, 0xf6, 0x07, 0xf0, 0x15,
+	0x3e, 0xf0, 0x01, 0x7c, 0x02, 0x51, 0x8f, 0x7e, 0x54, 0x3f, 0x66, 0xd4, 0x42, 0x70, 0xda, 0xae,
+	0xcc, 0xac, 0xac, 0x7c, 0x55, 0x66, 0x56, 0xce, 0x42, 0x8f, 0x2c, 0x88, 0x7b, 0x7a, 0x38, 0x0b,
+	0x7c, 0xe6, 0xa3, 0xde, 0xc4, 0x67, 0x17, 0xf4, 0x50, 0x80, 0x4c, 0xb5, 0x10, 0x18, 0xfc, 0x15,
+	0x34, 0x86, 0x8e, 0x3f, 0x45, 0x57, 0xa1, 0x4d, 0x1d, 0x7f, 0x3a, 0xa2, 0x6e, 0xdf, 0x18, 0x18,
+	0x07, 0x75, 0xab, 0xc5, 0x97, 0x43, 0x17, 0xed, 0x42, 0x97, 0x4e, 0xec, 0x31, 0x19, 0xcd, 0x03,
+	0xaf, 0x5f, 0x1b, 0x18, 0x07, 0x5d, 0xab, 0x23, 0x00, 0x2f, 0x02, 0x0f, 0x0d, 0xa0, 0xe7, 0x92,
+	0xd0, 0x09, 0xe8, 0x8c, 0x51, 0x7f, 0xda, 0xaf, 0x0b, 0x74, 0x1a, 0x84, 0xff, 0x6a, 0x40, 0xc3,
+	0xb2, 0x1d, 0xc2, 0x0f, 0x08, 0x6c, 0x87, 0xa4, 0x0e, 0xe0, 0xcb, 0xa1, 0x8b, 0x10, 0x34, 0xa6,
+	0xf6, 0x84, 0x28, 0xde, 0xe2, 0x7b, 0x35, 0x5f, 0xb4, 0x07, 0x10, 0x9e, 0xfb, 0x01, 0x1b, 0x71,
+	0x60, 0xbf, 0x21, 0x08, 0xba, 0x02, 0x72, 0x4c, 0x42, 0x07, 0xed, 0x43, 0x83, 0xcb, 0xdf, 0x6f,
+	0x0e, 0x8c, 0x83, 0xde, 0xd1, 0xf6, 0x61, 0x4a, 0xff, 0x43, 0xae, 0xaf, 0x25, 0xd0, 0xf8, 0xbb,
+	0x1a, 0x74, 0x1e, 0x4e, 0x1d, 0x12, 0xb2, 0xe0, 0x12, 0xdd, 0x84, 0x9e, 0xad, 0xbe, 0x13, 0x29,
+	0x21, 0x02, 0xbd, 0xb5, 0xa4, 0xb7, 0x60, 0xed, 0xd4, 0xf3, 0x7d, 0xd7, 0xa3, 0x53, 0xa1, 0x7d,
+	0x43, 0xf0, 0xed, 0xc5, 0xb0, 0xa1, 0x8b, 0x6e, 0x00, 0xcc, 0x48, 0xe0, 0x10, 0xc9, 0xa3, 0x29,
+	0x0f, 0x4e, 0x20, 0xe8, 0x3a, 0x74, 0xbf, 0xa6, 0x9e, 0x37, 0xf3, 0xbf, 0x26, 0x41, 0xbf, 0x25,
+	0xd0, 0x09, 0x00, 0x99, 0xd0, 0x71, 0xce, 0xed, 0x80, 0x86, 0x13, 0xbb, 0xdf, 0x16, 0xc8, 0x78,
+	0x8d, 0xde, 0x87, 0xd6, 0x84, 0x4c, 0xfc, 0xe0, 0xb2, 0xdf, 0x91, 0x46, 0x97, 0x2b, 0x84, 0x61,
+	0x8d, 0x4e, 0x19, 0xf1, 0x3c, 0x3a, 0x26, 0x53, 0x87, 0xf4, 0xbb, 0x02, 0xab, 0xc1, 0x32, 0x26,
+	0x86, 0x32, 0x13, 0xf7, 0x96, 0x9b, 0xf8, 0x1f, 0x0d, 0xe8, 0x3e, 0x8a, 0x74, 0xcd, 0x19, 0xc3,
+	0xc8, 0x1b, 0xa3, 0xc8, 0xca, 0xa9, 0xe0, 0xa9, 0x6b, 0xc1, 0x93, 0x31, 0x7f, 0x23, 0x6f, 0xfe,
+	0x5d, 0xe8, 0x4e, 0x6c, 0x8f, 0x48, 0x25, 0x9a, 0x32, 0x7e, 0x39, 0x40, 0xe8, 0x70, 0x13, 0x7a,
+	0x67, 0x24, 0x41, 0xb7, 0x04, 0x1a, 0xce, 0x48, 0x4c, 0x30, 0x80, 0xb5, 0xf0, 0x9c, 0xce, 0x46,
+	0xec, 0x72, 0x26, 0x4e, 0x97, 0xf6, 0x05, 0x0e, 0xfb, 0xfc, 0x72, 0xc6, 0x25, 0xd8, 0x87, 0x0d,
+	0xc7, 0x0f, 0x66, 0x7e, 0x60, 0xf3, 0xe3, 0x38, 0x8d, 0xb4, 0xf4, 0x7a, 0x0a, 0x9a, 0x73, 0x71,
+	0x77, 0xb9, 0x8b, 0x61, 0x99, 0x8b, 0x7b, 0xa5, 0x2e, 0x5e, 0x5b, 0xea, 0xe2, 0xf5, 0x95, 0x2e,
+	0xde, 0xc8, 0xba, 0xf8, 0x0e, 0x6c, 0x4a, 0x74, 0x62, 0xa2, 0x4d, 0x41, 0xb3, 0x2e, 0xc0, 0x4f,
+	0x22, 0x2b, 0x7d, 0x0f, 0xb6, 0x25, 0x5d, 0xda, 0x98, 0x5b, 0x82, 0x52, 0x32, 0xf8, 0x65, 0x62,
+	0xd1, 0x28, 0x6c, 0xb6, 0x97, 0x87, 0xcd, 0xdf, 0x0c, 0x68, 0x9d, 0x5c, 0x86, 0x8c, 0x4c, 0xb8,
+	0x07, 0x43, 0xf1, 0x95, 0x04, 0x4c, 0x47, 0x02, 0x4a, 0xa2, 0x65, 0x17, 0xba, 0x01, 0x19, 0x2b,
+	0x6f, 0xc8, 0x78, 0xe9, 0x48, 0xc0, 0xd0, 0x45, 0x77, 0x61, 0xcb, 0xf1, 0xa7, 0x21, 0x37, 0x42,
+	0xec, 0x31, 0x79, 0x25, 0x37, 0x35, 0xf8, 0xd0, 0xe5, 0x56, 0x0f, 0x89, 0x33, 0x0f, 0x28, 0xbb,
+	0x14, 0x91, 0x63, 0x58, 0xf1, 0x1a, 0x5f, 0xc0, 0xfa, 0x2f, 0xd2, 0xe4, 0x85, 0x7c, 0x8d, 0x62,
+	0xbe, 0x55, 0x65, 0xc6, 0x0f, 0xa0, 0x65, 0x89, 0x6f, 0x9d, 0xcc, 0xc8, 0xa8, 0x56, 0xc0, 0x17,
+	0xff, 0xdb, 0x80, 0xf6, 0x09, 0xb3, 0xe3, 0x9c, 0xc9, 0x32, 0xc2, 0x75, 0x15, 0x64, 0xe8, 0x0a,
+	0x6f, 0x2b, 0x74, 0x14, 0xee, 0x35, 0x19, 0xca, 0x0a, 0x5c, 0x1a, 0xf1, 0xf5, 0xa2, 0x88, 0xd7,
+	0xdc, 0xd6, 0xc8, 0xb8, 0xad, 0xc8, 0x5a, 0xcd, 0x62, 0x6b, 0x69, 0x2a, 0xb7, 0x4a, 0x54, 0x6e,
+	0xa7, 0x54, 0xfe, 0x12, 0x3a, 0x43, 0x46, 0x26, 0x5c, 0x5a, 0x9e, 0x38, 0x22, 0x5d, 0x54, 0xd5,
+	0x61, 0x52, 0x89, 0xb7, 0xca, 0xe5, 0xf8, 0xbb, 0x3a, 0x6c, 0x44, 0xbc, 0x8f, 0x09, 0xb3, 0xa9,
+	0xf7, 0x8e, 0x4f, 0x40, 0xd7, 0xa0, 0x33, 0x0e, 0xfc, 0xf9, 0x2c, 0x31, 0x5a, 0x5b, 0xac, 0x87,
+	0x2e, 0x77, 0x9f, 0x44, 0x09, 0xb6, 0x32, 0x95, 0x75, 0x05, 0xe4, 0x29, 0xe7, 0x7d, 0x13, 0x7a,
+	0x8e, 0xcd, 0xc8, 0xd8, 0x0f, 0x2e, 0x13, 0x4b, 0x41, 0x04, 0x1a, 0xba, 0xe8, 0x03, 0x58, 0x8f,
+	0x09, 0x52, 0x46, 0x5b, 0x8b, 0x80, 0x82, 0x0b, 0x82, 0xc6, 0xc4, 0x0e, 0x43, 0x91, 0xc4, 0x0c,
+	0x4b, 0x7c, 0xf3, 0x0c, 0xb3, 0xf0, 0xbd, 0xf9, 0x44, 0x96, 0x09, 0xc3, 0x52, 0x2b, 0x91, 0x95,
+	0xec, 0x99, 0xed, 0xf0, 0xfb, 0x01, 0xf2, 0x7e, 0x44, 0x6b, 0x9e, 0xe8, 0x67, 0x7e, 0x20, 0x5c,
+	0x1b, 0xd2, 0xd7, 0x44, 0x65, 0xad, 0x9e, 0x82, 0x9d, 0xd0, 0xd7, 0x22, 0xf9, 0x9c, 0xda, 0x21,
+	0x19, 0xcd, 0x02, 0xea, 0x10, 0x91, 0xbc, 0x0c, 0xab, 0xcb, 0x21, 0xcf, 0x38, 0x00, 0xdd, 0x86,
+	0x8d, 0x99, 0x1d, 0x90, 0x29, 0x8b, 0xa3, 0x51, 0x65, 0x30, 0x09, 0x55, 0xc1, 0x28, 0x0a, 0xca,
+	0x9c, 0xcc, 0x02, 0x3a, 0x65, 0x9c, 0x66, 0x23, 0x2a, 0x28, 0x0a, 0x36, 0x74, 0xd1, 0x87, 0x80,
+	0x5c, 0x12, 0xd0, 0x85, 0xcd, 0xe8, 0x82, 0xc4, 0xcc, 0x36, 0x07, 0xf5, 0x83, 0xba, 0xb5, 0x95,
+	0x60, 0x24, 0x43, 0xfc, 0x17, 0x03, 0xd6, 0x9f, 0xd8, 0x8c, 0x04, 0xd4, 0xf6, 0x4e, 0xce, 0x09,
+	0x61, 0xe8, 0x2e, 0x34, 0xf8, 0x26, 0xe1, 0xde, 0xde, 0xd1, 0x7b, 0x7a, 0xc6, 0x52, 0xc1, 0x60,
+	0x09, 0x12, 0x74, 0x9f, 0x17, 0x1b, 0xb9, 0x37, 0xec, 0xd7, 0x06, 0xf5, 0x1c, 0x7d, 0xc4, 0xd9,
+	0x4a, 0xe8, 0x84, 0xa9, 0x02, 0xdf, 0x9d, 0x3b, 0x24, 0x1c, 0xbd, 0x64, 0x97, 0xea, 0x36, 0xf5,
+	0x22, 0xd8, 0x73, 0x76, 0x89, 0x9f, 0x43, 0x27, 0xda, 0x59, 0x45, 0x1c, 0x13, 0x3a, 0x2f, 0xe7,
+	0xf6, 0x94, 0x71, 0x07, 0xc9, 0xab, 0x1c, 0xaf, 0xf1, 0x47, 0xb0, 0xf5, 0x98, 0x30, 0x99, 0x56,
+	0x2c, 0xf2, 0x72, 0x4e, 0x42, 0xb6, 0x34, 0xbb, 0xe0, 0x31, 0x6c, 0xa7, 0x36, 0x84, 0x33, 0x7f,
+	0x1a, 0x12, 0xb4, 0x0f, 0xad, 0x80, 0x84, 0x73, 0x8f, 0x29, 0x71, 0xd6, 0x95, 0x38, 0x96, 0x00,
+	0x5a, 0x0a, 0x89, 0xbe, 0xcf, 0xc9, 0xf8, 0x46, 0x21, 0x46, 0xef, 0xe8, 0x8a, 0x26, 0xb5, 0xe2,
+	0xa9, 0x48, 0xf0, 0x95, 0xd4, 0x41, 0xa1, 0x12, 0x0d, 0x9f, 0x03, 0x4a, 0x03, 0xdf, 0xfe, 0xf8,
+	0xfa, 0xaa, 0xe3, 0x8f, 0xe1, 0xea, 0x63, 0xc2, 0xb4, 0xe4, 0x1e, 0xd9, 0xe7, 0xcd, 0x73, 0x3c,
+	0xfe, 0xc6, 0x80, 0x7e, 0x9e, 0x4d, 0x35, 0xb1, 0x7f, 0x0e, 0xeb, 0x1a, 0x5b, 0x65, 0x3c, 0x53,
+	0x93, 0x5e, 0x3f, 0x41, 0xdf, 0xa0, 0x9c, 0x2c, 0xeb, 0x68, 0xca, 0xc9, 0xa5, 0xe5, 0x54, 0x39,
+	0x39, 0xda, 0x50, 0xd9, 0xca, 0x92, 0x4f, 0xa1, 0x93, 0x15, 0x4f, 0x45, 0x82, 0xef, 0xc2, 0x06,
+	0xf7, 0xa7, 0xed, 0x90, 0x48, 0xae, 0xb2, 0x07, 0x02, 0x1e, 0xc1, 0x66, 0x4c, 0x5a, 0x4d, 0xa2,
+	0x7d, 0x68, 0x70, 0x1e, 0xfd, 0x5a, 0x41, 0xaf, 0x21, 0xf8, 0x09, 0x34, 0xde, 0x8e, 0x0f, 0x88,
+	0xc3, 0xed, 0xb7, 0xb0, 0x95, 0x80, 0xde, 0xf6, 0xd0, 0xfa, 0xb2, 0x43, 0x7f, 0x02, 0x57, 0x1e,
+	0x13, 0x16, 0x77, 0xc6, 0x91, 0x15, 0x56, 0x37, 0xc8, 0x38, 0x84, 0x1d, 0x7d, 0x67, 0x35, 0xf9,
+	0x7e, 0x08, 0xdd, 0x98, 0x9b, 0xb2, 0xcc, 0xfb, 0x9a, 0x90, 0x09, 0xe7, 0x84, 0x10, 0xff, 0x48,
+	0xdc, 0xbf, 0xe8, 0xad, 0x14, 0x49, 0xbb, 0xea, 0xc9, 0x84, 0x7d, 0xb8, 0xa2, 0x6d, 0xab, 0x26,
+	0xea, 0xc7, 0xd0, 0x89, 0x78, 0xf5, 0x6b, 0x05, 0xe9, 0x2e, 0xe6, 0x1b, 0x93, 0xe1, 0x1f, 0x08,
+	0x39, 0xe3, 0x3c, 0x98, 0xc4, 0x56, 0x61, 0x91, 0xc6, 0x63, 0xb8, 0xa2, 0x91, 0x57, 0x93, 0x2f,
+	0x4a, 0xc5, 0xb5, 0x95, 0xa9, 0x18, 0xdf, 0x17, 0xe9, 0x40, 0xef, 0x1d, 0x56, 0x4a, 0x17, 0xc2,
+	0xb5, 0x82, 0x4d, 0xd5, 0x64, 0xfc, 0x48, 0x93, 0x71, 0xb7, 0x50, 0x46, 0xc5, 0x59, 0x4a, 0xfa,
+	0x14, 0xde, 0x7b, 0x3e, 0x27, 0xc1, 0x65, 0x84, 0x8c, 0xee, 0x04, 0xda, 0x81, 0xe6, 0x4b, 0x8e,
+	0x10, 0xe7, 0x75, 0x2d, 0xb9, 0xc8, 0xb6, 0x1d, 0xb5, 0x41, 0x5d, 0x6f, 0x3b, 0xb0, 0x07, 0xef,
+	0x67, 0xf9, 0x55, 0xcd, 0x2b, 0x4d, 0x2e, 0x58, 0x71, 0x41, 0x8d, 0xcd, 0x2c, 0x69, 0xf0, 0xe7,
+	0xb0, 0xab, 0x9d, 0x26, 0x55, 0xfb, 0x6f, 0x75, 0x78, 0x05, 0xd7, 0x8b, 0xb9, 0x56, 0x8d, 0x67,
+	0x4d, 0x93, 0xa5, 0xce, 0x50, 0xfa, 0x1c, 0x89, 0x6a, 0xa4, 0x35, 0x24, 0x2b, 0xc3, 0xe6, 0x35,
+	0xf4, 0xf3, 0x7b, 0xaa, 0x49, 0xfa, 0x63, 0xd1, 0xc8, 0x8c, 0x42, 0xbe, 0xb7, 0xb0, 0xec, 0xe8,
+	0xdc, 0x3b, 0x13, 0x9b, 0x89, 0x2f, 0x7c, 0x24, 0x0b, 0x08, 0xd3, 0xea, 0xe6, 0xf2, 0x87, 0x07,
+	0xbe, 0x00, 0x94, 0xde, 0x53, 0x4d, 0xd2, 0x43, 0x68, 0x2b, 0x4e, 0x4a, 0xce, 0x1d, 0xbd, 0xec,
+	0x28, 0xae, 0x11, 0x91, 0xea, 0x2e, 0xbe, 0x20, 0x41, 0x98, 0x08, 0x88, 0x5f, 0x00, 0x4a, 0x03,
+	0xab, 0x49, 0xd0, 0x87, 0xf6, 0x42, 0xee, 0x54, 0xbd, 0x7e, 0xb4, 0xc4, 0x5f, 0x41, 0xd3, 0xf2,
+	0xe7, 0x4c, 0x74, 0xd5, 0x67, 0x9e, 0x3d, 0x56, 0x51, 0x27, 0xbe, 0xb5, 0x72, 0x59, 0x5f, 0x51,
+	0x2e, 0x79, 0xdc, 0xfe, 0x6e, 0x3e, 0x99, 0x85, 0xaa, 0x39, 0x94, 0x0b, 0xfc, 0x07, 0x43, 0x56,
+	0x2e, 0x7e, 0x46, 0xaa, 0xbc, 0xfb, 0x01, 0x1d, 0xd3, 0x74, 0x0f, 0x27, 0x01, 0xf2, 0xe9, 0xe6,
+	0x92, 0x90, 0xd1, 0x69, 0xec, 0x0c, 0xf5, 0xc2, 0x4b, 0x41, 0xe5, 0xd3, 0x45, 0x88, 0x5b, 0x4f,
+	0x89, 0x7b, 0x0d, 0x3a, 0xf6, 0xc2, 0xa7, 0xae, 0x7c, 0x98, 0xf0, 0x0b, 0xd2, 0x16, 0xeb, 0xa1,
+	0x8b, 0x1d, 0xd8, 0x4a, 0xa4, 0xa8, 0x66, 0xbb, 0x03, 0x68, 0x06, 0x7c, 0x9f, 0xf2, 0x1d, 0xd2,
+	0xab, 0xa5, 0xe0, 0x28, 0x09, 0xf0, 0x0b, 0xa1, 0xea, 0x67, 0x5c, 0xef, 0x77, 0xa8, 0x2a, 0xfe,
+	0x35, 0x6c, 0x25, 0x6c, 0xab, 0xc9, 0x1e, 0xfb, 0xa4, 0x96, 0xf6, 0xc9, 0x6b, 0xd8, 0x1c, 0x4e,
+	0xdd, 0x39, 0x2f, 0x46, 0xcf, 0x44, 0x07, 0xcf, 0xde, 0x51, 0xc7, 0xce, 0x1f, 0x8f, 0xb3, 0xc0,
+	0x3f, 0xb5, 0x4f, 0xa9, 0x47, 0xd5, 0x33, 0xc1, 0xb0, 0xd2, 0x20, 0xfc, 0x0c, 0xd6, 0xa3, 0xb3,
+	0x4f, 0x2e, 0xa8, 0x57, 0xe9, 0xad, 0xb0, 0x03, 0x4d, 0x8f, 0x2c, 0x88, 0x17, 0x69, 0x23, 0x16,
+	0xf8, 0x5f, 0x06, 0x6c, 0x45, 0x2c, 0x1f, 0x3a, 0x8c, 0x2e, 0xb8, 0x20, 0xbc, 0xea, 0xab, 0xef,
+	0x74, 0xd5, 0x57, 0x20, 0x19, 0x3f, 0x8c, 0xaa, 0xa7, 0x6f, 0xdd, 0x12, 0xdf, 0xbc, 0x96, 0x47,
+	0x4f, 0x9e, 0x7e, 0x7d, 0xd9, 0xcb, 0x28, 0x26, 0x43, 0x9f, 0x40, 0x5b, 0x3e, 0x82, 0x98, 0x88,
+	0xb8, 0xde, 0xd1, 0x75, 0x5d, 0x01, 0xdd, 0xcc, 0x56, 0x44, 0x8c, 0xee, 0x41, 0x33, 0xe4, 0xea,
+	0xf7, 0x9b, 0x83, 0x7a, 0x2e, 0x71, 0x69, 0x06, 0xb2, 0x24, 0x21, 0xfe, 0xbb, 0x01, 0xdb, 0x11,
+	0xe2, 0x51, 0xf4, 0x74, 0xac, 0x62, 0xbd, 0x7b, 0xb0, 0x33, 0xb1, 0x5f, 0x8d, 0x94, 0x04, 0x3c,
+	0xe0, 0x3c, 0x3a, 0xa1, 0x4c, 0x59, 0x00, 0x4d, 0xec, 0x57, 0xcf, 0x62, 0xd4, 0xaf, 0x38, 0x06,
+	0x3d, 0x80, 0x4e, 0x64, 0x31, 0x65, 0x8f, 0xbd, 0x42, 0x39, 0x23, 0xab, 0x5b, 0x31, 0x39, 0xfe,
+	0x04, 0x76, 0x79, 0x5b, 0x90, 0x95, 0x77, 0x65, 0x5d, 0xf8, 0xc6, 0x80, 0xeb, 0xc5, 0x1b, 0xab,
+	0x05, 0xfe, 0x4f, 0x79, 0x07, 0xa9, 0xf6, 0xaa, 0x8b, 0x7b, 0xa3, 0x50, 0xf6, 0xe4, 0x84, 0x64,
+	0x03, 0xf6, 0xe1, 0x76, 0x91, 0x10, 0x8f, 0x62, 0x3f, 0x2a, 0x35, 0xee, 0xc0, 0xa6, 0x32, 0xe7,
+	0x48, 0x57, 0x67, 0x5d, 0x81, 0xd5, 0x04, 0x20, 0x13, 0x8d, 0xb5, 0x6c, 0x34, 0xe2, 0x3f, 0x19,
+	0xb0, 0xbf, 0xe2, 0xc4, 0xff, 0xa7, 0xfe, 0xdf, 0xd6, 0x60, 0xe3, 0xd8, 0x1f, 0x4f, 0xec, 0x87,
+	0x8c, 0x05, 0xf4, 0x94, 0x97, 0x87, 0x5b, 0xb0, 0x66, 0x47, 0x8b, 0x54, 0xd3, 0x1f, 0xc3, 0x4a,
+	0xa6, 0x49, 0xb7, 0x60, 0xcd, 0xa5, 0xe1, 0xcc, 0xb3, 0xd5, 0x3c, 0x27, 0x1a, 0x27, 0x49, 0xd8,
+	0xd3, 0x82, 0x81, 0x53, 0xc1, 0x7c, 0xfc, 0x2a, 0xb4, 0xe7, 0x53, 0xca, 0x92, 0x01, 0x5c, 0x8b,
+	0x2f, 0xe5, 0xb8, 0xc8, 0x25, 0x67, 0xf6, 0xdc, 0x63, 0xa3, 0x85, 0xed, 0xcd, 0x89, 0x98, 0x28,
+	0x19, 0xd6, 0x9a, 0x02, 0x7e, 0xc1, 0x61, 0x7c, 0x3e, 0x7e, 0x4e, 0xc7, 0xe7, 0x23, 0x1a, 0x8e,
+	0xc6, 0xbe, 0x2f, 0xe7, 0xe3, 0x1d, 0x0b, 0x38, 0x6c, 0x18, 0x3e, 0xf6, 0x7d, 0x97, 0x0f, 0xb6,
+	0x43, 0x66, 0x3b, 0x17, 0xf6, 0xa9, 0x47, 0xc4, 0x54, 0xa9, 0x63, 0x25, 0x00, 0x8e, 0x9d, 0xcd,
+	0x4f, 0x3d, 0x1a, 0x9e, 0x13, 0x57, 0x4c, 0x97, 0x3a, 0x56, 0x02, 0xe0, 0x39, 0x49, 0x1e, 0x2d,
+	0xa7, 0x4b, 0x72, 0x81, 0xbf, 0x35, 0xa0, 0x27, 0x0c, 0xf8, 0xe9, 0xd9, 0x19, 0x71, 0x44, 0x19,
+	0x20, 0xe2, 0x2b, 0x55, 0x06, 0x24, 0xe0, 0x7f, 0x69, 0x37, 0x31, 0xfc, 0x92, 0x0d, 0xa2, 0x32,
+	0x5c, 0xbc, 0xe6, 0xfd, 0x0e, 0x0d, 0x47, 0xca, 0x50, 0xc2, 0x6e, 0x1d, 0xab, 0x4b, 0xc3, 0x63,
+	0x09, 0xc0, 0x7f, 0x36, 0xa0, 0x2b, 0x3a, 0x3d, 0xae, 0x44, 0xf9, 0x00, 0xf1, 0x01, 0x74, 0xe3,
+	0x08, 0x28, 0xec, 0x18, 0xf5, 0x28, 0xb2, 0x12, 0x6a, 0x74, 0x0f, 0x5a, 0xd2, 0x02, 0x2a, 0xb5,
+	0xf4, 0xf3, 0xfb, 0xa4, 0xf1, 0x2c, 0x45, 0x87, 0x0f, 0xc5, 0x43, 0x28, 0x96, 0xaa, 0x30, 0x97,
+	0xd4, 0x53, 0xb9, 0xe4, 0x02, 0x76, 0x74, 0xfa, 0x6a, 0x57, 0xe8, 0x43, 0x68, 0xba, 0x7c, 0x9f,
+	0xd2, 0x4b, 0x7f, 0x80, 0x26, 0x5c, 0x25, 0x11, 0xfe, 0x99, 0x68, 0x68, 0x63, 0x4d, 0xb5, 0x57,
+	0x49, 0xfe, 0xee, 0xd4, 0x33, 0x77, 0x07, 0xff, 0x1e, 0xae, 0x15, 0x6c, 0xaf, 0x26, 0xf0, 0xdb,
+	0x3b, 0xe3, 0xe8, 0x9f, 0x1b, 0xb0, 0xf6, 0xe9, 0x82, 0x1c, 0x3f, 0x3a, 0x21, 0xc1, 0x82, 0x3a,
+	0x04, 0x3d, 0x01, 0x48, 0xba, 0x4d, 0xa4, 0xa7, 0x8e, 0x5c, 0x6f, 0x6a, 0xde, 0x2c, 0xc5, 0x2b,
+	0x0d, 0x3e, 0x83, 0x6e, 0x3c, 0x1a, 0x43, 0x7b, 0x59, 0x6a, 0x6d, 0xc2, 0x67, 0xde, 0x28, 0x43,
+	0x2b, 0x5e, 0x52, 0x34, 0x09, 0x0c, 0x51, 0x09, 0x75, 0x58, 0x2a, 0x5a, 0x76, 0x3e, 0x37, 0x12,
+	0xdd, 0x95, 0xfe, 0x43, 0xc9, 0xed, 0xec, 0xa6, 0xa2, 0x51, 0x9b, 0xb9, 0xbf, 0x82, 0x4a, 0xd3,
+	0x5d, 0xfd, 0x50, 0x94, 0xd3, 0x5d, 0x1b, 0x7c, 0x99, 0x37, 0xca, 0xd0, 0x9a, 0xee, 0xd1, 0x8f,
+	0x25, 0x79, 0x6a, 0xed, 0x4d, 0x63, 0xde, 0x2c, 0xc5, 0x2b, 0x76, 0xc7, 0xd0, 0x56, 0x23, 0x24,
+	0xb4, 0x9b, 0xb3, 0x53, 0x32, 0xf7, 0x32, 0xaf, 0x17, 0x23, 0x15, 0x97, 0xc7, 0xd0, 0x51, 0xa0,
+	0x10, 0x15, 0x52, 0xc6, 0xce, 0xd8, 0x2b, 0xc1, 0x2a, 0x46, 0x27, 0xb0, 0x96, 0x9e, 0x1a, 0xa1,
+	0x41, 0x96, 0x3c, 0x3b, 0x8a, 0x32, 0x6f, 0x2d, 0xa1, 0x50, 0x4c, 0x9f, 0x41, 0x2f, 0x35, 0xde,
+	0x41, 0x39, 0x9b, 0x64, 0xe6, 0x45, 0xe6, 0xa0, 0x9c, 0x40, 0xe3, 0x18, 0xff, 0x7e, 0x93, 0xe3,
+	0x98, 0x99, 0xec, 0x98, 0x83, 0x72, 0x02, 0xc5, 0xf1, 0x14, 0xb6, 0x53, 0x60, 0xf5, 0xab, 0xcd,
+	0x7e, 0xd9, 0x36, 0x6d, 0x32, 0x63, 0xde, 0x59, 0x45, 0xa6, 0xc5, 0xb9, 0xfe, 0xb3, 0x41, 0x2e,
+	0xce, 0x8b, 0x1e, 0xf1, 0xe6, 0xfe, 0x0a, 0x2a, 0x75, 0xc0, 0x97, 0xb0, 0xa1, 0x0f, 0x51, 0x10,
+	0xd6, 0x36, 0x16, 0x4e, 0x6c, 0xcc, 0x0f, 0x96, 0xd2, 0x28, 0xd6, 0x17, 0xb0, 0x53, 0x34, 0xdb,
+	0x40, 0x07, 0xe5, 0x9b, 0xf5, 0xa1, 0x8a, 0x79, 0xf7, 0x0d, 0x28, 0xf5, 0x70, 0x16, 0x8f, 0xe2,
+	0x7c, 0x38, 0xa7, 0xde, 0xb1, 0xe6, 0x5e, 0x09, 0x56, 0x63, 0x24, 0xde, 0x6d, 0x79, 0x46, 0xe9,
+	0x57, 0xa2, 0xb9, 0x57, 0x82, 0x4d, 0xd4, 0x2f, 0x6a, 0x0e, 0x33, 0xea, 0x2f, 0xe9, 0xb7, 0xcd,
+	0xbb, 0x6f, 0x40, 0xa9, 0x0e, 0xfb, 0xa3, 0x01, 0x7b, 0x4b, 0x5b, 0x51, 0xf4, 0xf1, 0x4a, 0x66,
+	0xd9, 0x46, 0xd9, 0x3c, 0xaa, 0xb2, 0x45, 0xcb, 0x06, 0x49, 0x13, 0x92, 0xbb, 0x46, 0xd9, 0x4e,
+	0xc0, 0xbc, 0xb5, 0x84, 0x42, 0xbb, 0x69, 0x7a, 0x9d, 0xcd, 0xdf, 0xb4, 0xc2, 0x32, 0x6e, 0xde,
+	0x59, 0x45, 0x26, 0xcf, 0x78, 0xd4, 0xfe, 0x4d, 0x53, 0xfc, 0xe3, 0xd2, 0x69, 0x4b, 0xfc, 0xb9,
+	0xff, 0x9f, 0x01, 0x00, 0x88, 0x98, 0xd5, 0x19, 0xe8, 0x24, 0x00, 0x00,
 }
@@ -106,6 +106,136 @@ func MarketPriceToProto(m *model.MarketPrice) *MarketPrice {
 	}
 }
 
+func ProtoToMarketTrend(p *MarketTrend) *model.MarketTrend {
+	t := &model.MarketTrend{
+		RegionID:    int(p.RegionId),
+		TypeID:      int(p.TypeId),
+		Window:      int(p.Window),
+		VolumeTrend: p.VolumeTrend,
+		Volatility:  p.Volatility,
+	}
+	for _, h := range p.History {
+		t.History = append(t.History, &model.MarketHistory{
+			RegionID: t.RegionID,
+			TypeID:   t.TypeID,
+			Date:     protoToTime(h.Date),
+			Volume:   int(h.Volume),
+			Avg:      decimal.NewFromFloat(h.Average),
+			Max:      decimal.NewFromFloat(h.Highest),
+			Min:      decimal.NewFromFloat(h.Lowest),
+		})
+		t.MovingAvg = append(t.MovingAvg, decimal.NewFromFloat(h.MovingAverage))
+		t.MovingVolume = append(t.MovingVolume, int(h.MovingVolume))
+	}
+	return t
+}
+
+func MarketTrendToProto(m *model.MarketTrend) *MarketTrend {
+	t := &MarketTrend{
+		RegionId:    int64(m.RegionID),
+		TypeId:      int64(m.TypeID),
+		Window:      int32(m.Window),
+		VolumeTrend: m.VolumeTrend,
+		Volatility:  m.Volatility,
+	}
+	for i, h := range m.History {
+		avg, _ := h.Avg.Float64()
+		max, _ := h.Max.Float64()
+		min, _ := h.Min.Float64()
+		mavg, _ := m.MovingAvg[i].Float64()
+		t.History = append(t.History, &MarketHistory{
+			Date:          timeToProto(h.Date),
+			Volume:        int64(h.Volume),
+			Average:       avg,
+			Highest:       max,
+			Lowest:        min,
+			MovingAverage: mavg,
+			MovingVolume:  int64(m.MovingVolume[i]),
+		})
+	}
+	return t
+}
+
+func ProtoToReprocessParams(p *ReprocessParams) model.ReprocessParams {
+	r := model.ReprocessParams{
+		BaseYield: p.BaseYield,
+		Implant:   p.Implant,
+		Tax:       p.Tax,
+	}
+	if p.Skills != nil {
+		r.Skills = model.ReprocessSkills{
+			Reprocessing:           int(p.Skills.Reprocessing),
+			ReprocessingEfficiency: int(p.Skills.ReprocessingEfficiency),
+			OreProcessing:          int(p.Skills.OreProcessing),
+			ScrapmetalProcessing:   int(p.Skills.ScrapmetalProcessing),
+		}
+	}
+	return r
+}
+
+func ReprocessParamsToProto(m model.ReprocessParams) *ReprocessParams {
+	return &ReprocessParams{
+		BaseYield: m.BaseYield,
+		Skills: &ReprocessSkills{
+			Reprocessing:           int32(m.Skills.Reprocessing),
+			ReprocessingEfficiency: int32(m.Skills.ReprocessingEfficiency),
+			OreProcessing:          int32(m.Skills.OreProcessing),
+			ScrapmetalProcessing:   int32(m.Skills.ScrapmetalProcessing),
+		},
+		Implant: m.Implant,
+		Tax:     m.Tax,
+	}
+}
+
+func ProtoToReprocessItem(p *ReprocessItem) *model.ReprocessItem {
+	return &model.ReprocessItem{
+		TypeID:   int(p.TypeId),
+		Quantity: int(p.Quantity),
+	}
+}
+
+func ReprocessItemToProto(m *model.ReprocessItem) *ReprocessItem {
+	return &ReprocessItem{
+		TypeId:   int64(m.TypeID),
+		Quantity: int64(m.Quantity),
+	}
+}
+
+func ProtoToReprocessResult(p *ReprocessResult) *model.ReprocessResult {
+	r := &model.ReprocessResult{Value: decimal.NewFromFloat(p.Value)}
+	for _, m := range p.Material {
+		r.Materials = append(r.Materials, &model.ReprocessMaterial{
+			TypeID:   int(m.TypeId),
+			Quantity: int(m.Quantity),
+			Price:    decimal.NewFromFloat(m.Price),
+			Value:    decimal.NewFromFloat(m.Value),
+		})
+	}
+	for _, it := range p.Leftover {
+		r.Leftovers = append(r.Leftovers, ProtoToReprocessItem(it))
+	}
+	return r
+}
+
+func ReprocessResultToProto(m *model.ReprocessResult) *ReprocessResult {
+	val, _ := m.Value.Float64()
+	r := &ReprocessResult{Value: val}
+	for _, mat := range m.Materials {
+		price, _ := mat.Price.Float64()
+		value, _ := mat.Value.Float64()
+		r.Material = append(r.Material, &ReprocessMaterial{
+			TypeId:   int64(mat.TypeID),
+			Quantity: int64(mat.Quantity),
+			Price:    price,
+			Value:    value,
+		})
+	}
+	for _, it := range m.Leftovers {
+		r.Leftover = append(r.Leftover, ReprocessItemToProto(it))
+	}
+	return r
+}
+
 func ProtoToProduct(m *Product) *model.Product {
 	kind := model.ProductBuild
 	if m.Kind == Product_BUY {
@@ -284,6 +414,22 @@ func SystemToProto(m *evedb.System) *System {
 	}
 }
 
+func ProtoToRoute(p *Route) *evedb.Route {
+	r := &evedb.Route{Flag: evedb.RouteFlag(p.Flag)}
+	for _, s := range p.System {
+		r.Systems = append(r.Systems, ProtoToSystem(s))
+	}
+	return r
+}
+
+func RouteToProto(m *evedb.Route) *Route {
+	r := &Route{Flag: string(m.Flag), Jumps: int64(m.Jumps())}
+	for _, s := range m.Systems {
+		r.System = append(r.System, SystemToProto(s))
+	}
+	return r
+}
+
 func ProtoToConstellation(p *Constellation) *evedb.Constellation {
 	return &evedb.Constellation{
 		ConstellationID: int(p.ConstellationId),
@@ -423,6 +569,145 @@ func MaterialToProto(m *evedb.Material) *Material {
 	}
 }
 
+func ProtoToIndustryBlueprint(p *IndustryBlueprint) *evedb.Blueprint {
+	bp := &evedb.Blueprint{
+		ItemType:           ProtoToItemType(p.Type),
+		MaxProductionLimit: int(p.MaxProductionLimit),
+	}
+	for _, act := range p.Activity {
+		bp.Activities = append(bp.Activities, ProtoToIndustryActivity(act))
+	}
+	return bp
+}
+
+func IndustryBlueprintToProto(m *evedb.Blueprint) *IndustryBlueprint {
+	bp := &IndustryBlueprint{
+		Type:               ItemTypeToProto(m.ItemType),
+		MaxProductionLimit: int64(m.MaxProductionLimit),
+	}
+	for _, act := range m.Activities {
+		bp.Activity = append(bp.Activity, IndustryActivityToProto(act))
+	}
+	return bp
+}
+
+func ProtoToIndustryActivity(p *IndustryActivity) *evedb.Activity {
+	act := &evedb.Activity{
+		Type: evedb.ActivityType(p.ActivityId),
+		Time: time.Duration(p.Time) * time.Second,
+	}
+	for _, mat := range p.Material {
+		act.Materials = append(act.Materials, ProtoToMaterial(mat))
+	}
+	for _, prod := range p.Product {
+		act.Products = append(act.Products, &evedb.ActivityProduct{
+			ItemType:    ProtoToItemType(prod.Type),
+			Quantity:    int(prod.Quantity),
+			Probability: prod.Probability,
+		})
+	}
+	for _, skill := range p.Skill {
+		act.Skills = append(act.Skills, &evedb.ActivitySkill{
+			ItemType: ProtoToItemType(skill.Type),
+			Level:    int(skill.Level),
+		})
+	}
+	return act
+}
+
+func IndustryActivityToProto(m *evedb.Activity) *IndustryActivity {
+	act := &IndustryActivity{
+		ActivityId: int64(m.Type),
+		Time:       int64(m.Time / time.Second),
+	}
+	for _, mat := range m.Materials {
+		act.Material = append(act.Material, MaterialToProto(mat))
+	}
+	for _, prod := range m.Products {
+		act.Product = append(act.Product, &IndustryProduct{
+			Type:        ItemTypeToProto(prod.ItemType),
+			Quantity:    int64(prod.Quantity),
+			Probability: prod.Probability,
+		})
+	}
+	for _, skill := range m.Skills {
+		act.Skill = append(act.Skill, &IndustrySkill{
+			Type:  ItemTypeToProto(skill.ItemType),
+			Level: int64(skill.Level),
+		})
+	}
+	return act
+}
+
+func ProtoToAttributeType(p *DogmaAttribute) *evedb.AttributeType {
+	return &evedb.AttributeType{
+		ID:           int(p.AttributeId),
+		Name:         p.Name,
+		DisplayName:  p.DisplayName,
+		Description:  p.Description,
+		UnitID:       int(p.UnitId),
+		DefaultValue: p.DefaultValue,
+		HighIsGood:   p.HighIsGood,
+		Stackable:    p.Stackable,
+		Published:    p.Published,
+	}
+}
+
+func AttributeTypeToProto(m *evedb.AttributeType) *DogmaAttribute {
+	return &DogmaAttribute{
+		AttributeId:  int64(m.ID),
+		Name:         m.Name,
+		DisplayName:  m.DisplayName,
+		Description:  m.Description,
+		UnitId:       int64(m.UnitID),
+		DefaultValue: m.DefaultValue,
+		HighIsGood:   m.HighIsGood,
+		Stackable:    m.Stackable,
+		Published:    m.Published,
+	}
+}
+
+func ProtoToTypeDogma(p *TypeDogma) *evedb.TypeDogma {
+	d := &evedb.TypeDogma{TypeID: int(p.TypeId)}
+	for _, attr := range p.Attribute {
+		d.Attributes = append(d.Attributes, &evedb.Attribute{
+			AttributeType: ProtoToAttributeType(attr),
+			Value:         attr.Value,
+		})
+	}
+	for _, eff := range p.Effect {
+		d.Effects = append(d.Effects, &evedb.Effect{
+			ID:          int(eff.EffectId),
+			Name:        eff.Name,
+			DisplayName: eff.DisplayName,
+			Description: eff.Description,
+			Category:    int(eff.Category),
+			IsDefault:   eff.IsDefault,
+		})
+	}
+	return d
+}
+
+func TypeDogmaToProto(m *evedb.TypeDogma) *TypeDogma {
+	d := &TypeDogma{TypeId: int64(m.TypeID)}
+	for _, attr := range m.Attributes {
+		a := AttributeTypeToProto(attr.AttributeType)
+		a.Value = attr.Value
+		d.Attribute = append(d.Attribute, a)
+	}
+	for _, eff := range m.Effects {
+		d.Effect = append(d.Effect, &DogmaEffect{
+			EffectId:    int64(eff.ID),
+			Name:        eff.Name,
+			DisplayName: eff.DisplayName,
+			Description: eff.Description,
+			Category:    int64(eff.Category),
+			IsDefault:   eff.IsDefault,
+		})
+	}
+	return d
+}
+
 func ProtoToBlueprint(p *Blueprint) *model.Blueprint {
 	kind := model.BlueprintOriginal
 	if p.Kind == Blueprint_COPY {
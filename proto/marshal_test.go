@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/motki/core/evedb"
 	"github.com/motki/core/proto"
 )
 
@@ -163,3 +164,218 @@ func TestMarshalAlliance(t *testing.T) {
 		t.Errorf("expected proto ticker to be 'TRST', got %s", palliance.Ticker)
 	}
 }
+
+func TestMarshalMarketTrend(t *testing.T) {
+	trend := proto.ProtoToMarketTrend(&proto.MarketTrend{
+		RegionId:    10000002,
+		TypeId:      34,
+		Window:      7,
+		VolumeTrend: 0.25,
+		Volatility:  0.1,
+		History: []*proto.MarketHistory{
+			{
+				Date:          &timestamp.Timestamp{Seconds: 1514764800},
+				Volume:        100,
+				Average:       5.5,
+				Highest:       6,
+				Lowest:        5,
+				MovingAverage: 5.25,
+				MovingVolume:  90,
+			},
+		},
+	})
+
+	if trend.RegionID != 10000002 {
+		t.Errorf("expected model region ID to be 10000002, got %d", trend.RegionID)
+	}
+	if trend.Window != 7 {
+		t.Errorf("expected model window to be 7, got %d", trend.Window)
+	}
+	if len(trend.History) != 1 || len(trend.MovingAvg) != 1 || len(trend.MovingVolume) != 1 {
+		t.Fatalf("expected 1 day of model history, got %d", len(trend.History))
+	}
+	if trend.History[0].TypeID != 34 {
+		t.Errorf("expected model history type ID to be 34, got %d", trend.History[0].TypeID)
+	}
+	if trend.History[0].Date.Unix() != 1514764800 {
+		t.Errorf("expected model history date to be 1514764800, got %d", trend.History[0].Date.Unix())
+	}
+	if trend.MovingVolume[0] != 90 {
+		t.Errorf("expected model moving volume to be 90, got %d", trend.MovingVolume[0])
+	}
+
+	ptrend := proto.MarketTrendToProto(trend)
+	if ptrend.VolumeTrend != 0.25 {
+		t.Errorf("expected proto volume trend to be 0.25, got %v", ptrend.VolumeTrend)
+	}
+	if len(ptrend.History) != 1 {
+		t.Fatalf("expected 1 day of proto history, got %d", len(ptrend.History))
+	}
+	h := ptrend.History[0]
+	if h.Average != 5.5 || h.Highest != 6 || h.Lowest != 5 {
+		t.Errorf("expected proto prices 5.5, 6 and 5, got %v, %v and %v", h.Average, h.Highest, h.Lowest)
+	}
+	if h.MovingAverage != 5.25 {
+		t.Errorf("expected proto moving average to be 5.25, got %v", h.MovingAverage)
+	}
+}
+
+func TestMarshalRoute(t *testing.T) {
+	route := proto.ProtoToRoute(&proto.Route{
+		Flag:  "secure",
+		Jumps: 1,
+		System: []*proto.System{
+			{SystemId: 30000142, Name: "Jita", Security: 0.9},
+			{SystemId: 30000144, Name: "Perimeter", Security: 1.0},
+		},
+	})
+
+	if route.Flag != evedb.RouteSecure {
+		t.Errorf("expected model flag to be secure, got %s", route.Flag)
+	}
+	if route.Jumps() != 1 {
+		t.Errorf("expected model route to have 1 jump, got %d", route.Jumps())
+	}
+	if route.Systems[1].Name != "Perimeter" {
+		t.Errorf("expected model destination to be Perimeter, got %s", route.Systems[1].Name)
+	}
+
+	proute := proto.RouteToProto(route)
+	if proute.Jumps != 1 {
+		t.Errorf("expected proto route to have 1 jump, got %d", proute.Jumps)
+	}
+	if len(proute.System) != 2 || proute.System[0].SystemId != 30000142 {
+		t.Errorf("expected proto route to start at 30000142, got %v", proute.System)
+	}
+}
+
+func TestMarshalIndustryBlueprint(t *testing.T) {
+	bp := proto.ProtoToIndustryBlueprint(&proto.IndustryBlueprint{
+		Type:               &proto.ItemType{TypeId: 681, Name: "Clone Vat Bay I Blueprint"},
+		MaxProductionLimit: 300,
+		Activity: []*proto.IndustryActivity{
+			{
+				ActivityId: 8,
+				Time:       63900,
+				Material:   []*proto.Material{{Type: &proto.ItemType{TypeId: 20418}, Quantity: 2}},
+				Product:    []*proto.IndustryProduct{{Type: &proto.ItemType{TypeId: 1178}, Quantity: 1, Probability: 0.3}},
+				Skill:      []*proto.IndustrySkill{{Type: &proto.ItemType{TypeId: 3402}, Level: 1}},
+			},
+		},
+	})
+
+	if bp.ID != 681 || bp.MaxProductionLimit != 300 {
+		t.Errorf("expected model blueprint 681 with limit 300, got %d with limit %d", bp.ID, bp.MaxProductionLimit)
+	}
+	act := bp.Activity(evedb.ActivityInvention)
+	if act == nil {
+		t.Fatalf("expected model invention activity, got nil")
+	}
+	if act.Time.Seconds() != 63900 {
+		t.Errorf("expected model activity time to be 63900s, got %v", act.Time)
+	}
+	if len(act.Products) != 1 || act.Products[0].Probability != 0.3 {
+		t.Errorf("expected model product with probability 0.3, got %v", act.Products)
+	}
+	if len(act.Skills) != 1 || act.Skills[0].Level != 1 {
+		t.Errorf("expected model skill at level 1, got %v", act.Skills)
+	}
+
+	pbp := proto.IndustryBlueprintToProto(bp)
+	if len(pbp.Activity) != 1 {
+		t.Fatalf("expected 1 proto activity, got %d", len(pbp.Activity))
+	}
+	pact := pbp.Activity[0]
+	if pact.ActivityId != 8 || pact.Time != 63900 {
+		t.Errorf("expected proto activity 8 taking 63900s, got %d taking %ds", pact.ActivityId, pact.Time)
+	}
+	if len(pact.Material) != 1 || pact.Material[0].Quantity != 2 {
+		t.Errorf("expected proto material with quantity 2, got %v", pact.Material)
+	}
+}
+
+func TestMarshalReprocess(t *testing.T) {
+	params := proto.ProtoToReprocessParams(&proto.ReprocessParams{
+		BaseYield: 0.5,
+		Skills:    &proto.ReprocessSkills{Reprocessing: 5, ScrapmetalProcessing: 3},
+		Tax:       0.05,
+	})
+	if params.BaseYield != 0.5 || params.Tax != 0.05 {
+		t.Errorf("expected model yield 0.5 and tax 0.05, got %v and %v", params.BaseYield, params.Tax)
+	}
+	if params.Skills.Reprocessing != 5 || params.Skills.ScrapmetalProcessing != 3 {
+		t.Errorf("expected model skills 5 and 3, got %d and %d", params.Skills.Reprocessing, params.Skills.ScrapmetalProcessing)
+	}
+	if proto.ProtoToReprocessParams(&proto.ReprocessParams{BaseYield: 0.5}).Skills.Reprocessing != 0 {
+		t.Errorf("expected zero skills without proto skills")
+	}
+
+	res := proto.ProtoToReprocessResult(&proto.ReprocessResult{
+		Value: 60,
+		Material: []*proto.ReprocessMaterial{
+			{TypeId: 34, Quantity: 10, Price: 6, Value: 60},
+		},
+		Leftover: []*proto.ReprocessItem{
+			{TypeId: 1230, Quantity: 50},
+		},
+	})
+	if len(res.Materials) != 1 || len(res.Leftovers) != 1 {
+		t.Fatalf("expected 1 model material and leftover, got %d and %d", len(res.Materials), len(res.Leftovers))
+	}
+	if res.Materials[0].TypeID != 34 || res.Materials[0].Quantity != 10 {
+		t.Errorf("expected model material 10 of type 34, got %d of type %d", res.Materials[0].Quantity, res.Materials[0].TypeID)
+	}
+	if res.Leftovers[0].Quantity != 50 {
+		t.Errorf("expected model leftover quantity to be 50, got %d", res.Leftovers[0].Quantity)
+	}
+
+	pres := proto.ReprocessResultToProto(res)
+	if pres.Value != 60 {
+		t.Errorf("expected proto value to be 60, got %v", pres.Value)
+	}
+	if len(pres.Material) != 1 || pres.Material[0].Price != 6 {
+		t.Errorf("expected proto material price to be 6, got %v", pres.Material)
+	}
+}
+
+func TestMarshalTypeDogma(t *testing.T) {
+	d := proto.ProtoToTypeDogma(&proto.TypeDogma{
+		TypeId: 2281,
+		Attribute: []*proto.DogmaAttribute{
+			{AttributeId: 50, Name: "cpu", DisplayName: "CPU usage", UnitId: 106, Stackable: true, Value: 44},
+			{AttributeId: 182, Name: "requiredSkill1", Value: 3416},
+			{AttributeId: 277, Name: "requiredSkill1Level", Value: 1},
+		},
+		Effect: []*proto.DogmaEffect{
+			{EffectId: 13, Name: "medPower", Category: 0, IsDefault: false},
+		},
+	})
+
+	if d.TypeID != 2281 {
+		t.Errorf("expected model type ID to be 2281, got %d", d.TypeID)
+	}
+	cpu := d.Attribute(evedb.AttributeCPU)
+	if cpu == nil {
+		t.Fatalf("expected model cpu attribute, got nil")
+	}
+	if cpu.Value != 44 || cpu.UnitID != 106 || !cpu.Stackable || cpu.DisplayName != "CPU usage" {
+		t.Errorf("unexpected model cpu attribute: %+v", cpu)
+	}
+	if skills := d.RequiredSkills(); len(skills) != 1 || skills[0].SkillID != 3416 || skills[0].Level != 1 {
+		t.Errorf("expected model to require skill 3416 at level 1, got %v", skills)
+	}
+	if !d.HasEffect(evedb.EffectMedPower) {
+		t.Errorf("expected model med slot effect, got %v", d.Effects)
+	}
+
+	pd := proto.TypeDogmaToProto(d)
+	if len(pd.Attribute) != 3 || len(pd.Effect) != 1 {
+		t.Fatalf("expected 3 proto attributes and 1 effect, got %d and %d", len(pd.Attribute), len(pd.Effect))
+	}
+	if pd.Attribute[0].AttributeId != 50 || pd.Attribute[0].Value != 44 || pd.Attribute[0].Name != "cpu" {
+		t.Errorf("unexpected proto cpu attribute: %v", pd.Attribute[0])
+	}
+	if pd.Effect[0].EffectId != 13 || pd.Effect[0].Name != "medPower" {
+		t.Errorf("unexpected proto effect: %v", pd.Effect[0])
+	}
+}
@@ -48,7 +48,7 @@ func (x Role) String() string {
 	return proto.EnumName(Role_name, int32(x))
 }
 func (Role) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{0}
+	return fileDescriptor_model_68779e9206c79477, []int{0}
 }
 
 type Product_Kind int32
@@ -71,7 +71,7 @@ func (x Product_Kind) String() string {
 	return proto.EnumName(Product_Kind_name, int32(x))
 }
 func (Product_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{15, 0}
+	return fileDescriptor_model_68779e9206c79477, []int{15, 0}
 }
 
 // Kind is blueprint original (BPO) or copy (BPC)
@@ -95,7 +95,7 @@ func (x Blueprint_Kind) String() string {
 	return proto.EnumName(Blueprint_Kind_name, int32(x))
 }
 func (Blueprint_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{26, 0}
+	return fileDescriptor_model_68779e9206c79477, []int{30, 0}
 }
 
 // A Character is a player-controlled character.
@@ -118,7 +118,7 @@ func (m *Character) Reset()         { *m = Character{} }
 func (m *Character) String() string { return proto.CompactTextString(m) }
 func (*Character) ProtoMessage()    {}
 func (*Character) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{0}
+	return fileDescriptor_model_68779e9206c79477, []int{0}
 }
 func (m *Character) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Character.Unmarshal(m, b)
@@ -218,7 +218,7 @@ func (m *Corporation) Reset()         { *m = Corporation{} }
 func (m *Corporation) String() string { return proto.CompactTextString(m) }
 func (*Corporation) ProtoMessage()    {}
 func (*Corporation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{1}
+	return fileDescriptor_model_68779e9206c79477, []int{1}
 }
 func (m *Corporation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Corporation.Unmarshal(m, b)
@@ -295,7 +295,7 @@ func (m *Alliance) Reset()         { *m = Alliance{} }
 func (m *Alliance) String() string { return proto.CompactTextString(m) }
 func (*Alliance) ProtoMessage()    {}
 func (*Alliance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{2}
+	return fileDescriptor_model_68779e9206c79477, []int{2}
 }
 func (m *Alliance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Alliance.Unmarshal(m, b)
@@ -358,7 +358,7 @@ func (m *Structure) Reset()         { *m = Structure{} }
 func (m *Structure) String() string { return proto.CompactTextString(m) }
 func (*Structure) ProtoMessage()    {}
 func (*Structure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{3}
+	return fileDescriptor_model_68779e9206c79477, []int{3}
 }
 func (m *Structure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Structure.Unmarshal(m, b)
@@ -433,7 +433,7 @@ func (m *CorporationStructure) Reset()         { *m = CorporationStructure{} }
 func (m *CorporationStructure) String() string { return proto.CompactTextString(m) }
 func (*CorporationStructure) ProtoMessage()    {}
 func (*CorporationStructure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{4}
+	return fileDescriptor_model_68779e9206c79477, []int{4}
 }
 func (m *CorporationStructure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CorporationStructure.Unmarshal(m, b)
@@ -578,7 +578,7 @@ func (m *GetCharacterRequest) Reset()         { *m = GetCharacterRequest{} }
 func (m *GetCharacterRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCharacterRequest) ProtoMessage()    {}
 func (*GetCharacterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{5}
+	return fileDescriptor_model_68779e9206c79477, []int{5}
 }
 func (m *GetCharacterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCharacterRequest.Unmarshal(m, b)
@@ -631,7 +631,7 @@ func (m *CharacterResponse) Reset()         { *m = CharacterResponse{} }
 func (m *CharacterResponse) String() string { return proto.CompactTextString(m) }
 func (*CharacterResponse) ProtoMessage()    {}
 func (*CharacterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{6}
+	return fileDescriptor_model_68779e9206c79477, []int{6}
 }
 func (m *CharacterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CharacterResponse.Unmarshal(m, b)
@@ -677,7 +677,7 @@ func (m *GetCorporationRequest) Reset()         { *m = GetCorporationRequest{} }
 func (m *GetCorporationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCorporationRequest) ProtoMessage()    {}
 func (*GetCorporationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{7}
+	return fileDescriptor_model_68779e9206c79477, []int{7}
 }
 func (m *GetCorporationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCorporationRequest.Unmarshal(m, b)
@@ -723,7 +723,7 @@ func (m *CorporationResponse) Reset()         { *m = CorporationResponse{} }
 func (m *CorporationResponse) String() string { return proto.CompactTextString(m) }
 func (*CorporationResponse) ProtoMessage()    {}
 func (*CorporationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{8}
+	return fileDescriptor_model_68779e9206c79477, []int{8}
 }
 func (m *CorporationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CorporationResponse.Unmarshal(m, b)
@@ -769,7 +769,7 @@ func (m *GetAllianceRequest) Reset()         { *m = GetAllianceRequest{} }
 func (m *GetAllianceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAllianceRequest) ProtoMessage()    {}
 func (*GetAllianceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{9}
+	return fileDescriptor_model_68779e9206c79477, []int{9}
 }
 func (m *GetAllianceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAllianceRequest.Unmarshal(m, b)
@@ -815,7 +815,7 @@ func (m *AllianceResponse) Reset()         { *m = AllianceResponse{} }
 func (m *AllianceResponse) String() string { return proto.CompactTextString(m) }
 func (*AllianceResponse) ProtoMessage()    {}
 func (*AllianceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{10}
+	return fileDescriptor_model_68779e9206c79477, []int{10}
 }
 func (m *AllianceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllianceResponse.Unmarshal(m, b)
@@ -861,7 +861,7 @@ func (m *GetStructureRequest) Reset()         { *m = GetStructureRequest{} }
 func (m *GetStructureRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStructureRequest) ProtoMessage()    {}
 func (*GetStructureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{11}
+	return fileDescriptor_model_68779e9206c79477, []int{11}
 }
 func (m *GetStructureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStructureRequest.Unmarshal(m, b)
@@ -907,7 +907,7 @@ func (m *GetStructureResponse) Reset()         { *m = GetStructureResponse{} }
 func (m *GetStructureResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStructureResponse) ProtoMessage()    {}
 func (*GetStructureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{12}
+	return fileDescriptor_model_68779e9206c79477, []int{12}
 }
 func (m *GetStructureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStructureResponse.Unmarshal(m, b)
@@ -952,7 +952,7 @@ func (m *GetCorpStructuresRequest) Reset()         { *m = GetCorpStructuresReque
 func (m *GetCorpStructuresRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCorpStructuresRequest) ProtoMessage()    {}
 func (*GetCorpStructuresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{13}
+	return fileDescriptor_model_68779e9206c79477, []int{13}
 }
 func (m *GetCorpStructuresRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCorpStructuresRequest.Unmarshal(m, b)
@@ -991,7 +991,7 @@ func (m *GetCorpStructuresResponse) Reset()         { *m = GetCorpStructuresResp
 func (m *GetCorpStructuresResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCorpStructuresResponse) ProtoMessage()    {}
 func (*GetCorpStructuresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{14}
+	return fileDescriptor_model_68779e9206c79477, []int{14}
 }
 func (m *GetCorpStructuresResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCorpStructuresResponse.Unmarshal(m, b)
@@ -1046,7 +1046,7 @@ func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{15}
+	return fileDescriptor_model_68779e9206c79477, []int{15}
 }
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Product.Unmarshal(m, b)
@@ -1148,7 +1148,7 @@ func (m *ProductResponse) Reset()         { *m = ProductResponse{} }
 func (m *ProductResponse) String() string { return proto.CompactTextString(m) }
 func (*ProductResponse) ProtoMessage()    {}
 func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{16}
+	return fileDescriptor_model_68779e9206c79477, []int{16}
 }
 func (m *ProductResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProductResponse.Unmarshal(m, b)
@@ -1194,7 +1194,7 @@ func (m *GetProductRequest) Reset()         { *m = GetProductRequest{} }
 func (m *GetProductRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProductRequest) ProtoMessage()    {}
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{17}
+	return fileDescriptor_model_68779e9206c79477, []int{17}
 }
 func (m *GetProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProductRequest.Unmarshal(m, b)
@@ -1240,7 +1240,7 @@ func (m *NewProductRequest) Reset()         { *m = NewProductRequest{} }
 func (m *NewProductRequest) String() string { return proto.CompactTextString(m) }
 func (*NewProductRequest) ProtoMessage()    {}
 func (*NewProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{18}
+	return fileDescriptor_model_68779e9206c79477, []int{18}
 }
 func (m *NewProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewProductRequest.Unmarshal(m, b)
@@ -1286,7 +1286,7 @@ func (m *SaveProductRequest) Reset()         { *m = SaveProductRequest{} }
 func (m *SaveProductRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveProductRequest) ProtoMessage()    {}
 func (*SaveProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{19}
+	return fileDescriptor_model_68779e9206c79477, []int{19}
 }
 func (m *SaveProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SaveProductRequest.Unmarshal(m, b)
@@ -1331,7 +1331,7 @@ func (m *GetProductsRequest) Reset()         { *m = GetProductsRequest{} }
 func (m *GetProductsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProductsRequest) ProtoMessage()    {}
 func (*GetProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{20}
+	return fileDescriptor_model_68779e9206c79477, []int{20}
 }
 func (m *GetProductsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProductsRequest.Unmarshal(m, b)
@@ -1370,7 +1370,7 @@ func (m *UpdateProductPricesRequest) Reset()         { *m = UpdateProductPricesR
 func (m *UpdateProductPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateProductPricesRequest) ProtoMessage()    {}
 func (*UpdateProductPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{21}
+	return fileDescriptor_model_68779e9206c79477, []int{21}
 }
 func (m *UpdateProductPricesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateProductPricesRequest.Unmarshal(m, b)
@@ -1416,7 +1416,7 @@ func (m *ProductsResponse) Reset()         { *m = ProductsResponse{} }
 func (m *ProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*ProductsResponse) ProtoMessage()    {}
 func (*ProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{22}
+	return fileDescriptor_model_68779e9206c79477, []int{22}
 }
 func (m *ProductsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProductsResponse.Unmarshal(m, b)
@@ -1464,7 +1464,7 @@ func (m *MarketPrice) Reset()         { *m = MarketPrice{} }
 func (m *MarketPrice) String() string { return proto.CompactTextString(m) }
 func (*MarketPrice) ProtoMessage()    {}
 func (*MarketPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{23}
+	return fileDescriptor_model_68779e9206c79477, []int{23}
 }
 func (m *MarketPrice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarketPrice.Unmarshal(m, b)
@@ -1517,7 +1517,7 @@ func (m *GetMarketPriceRequest) Reset()         { *m = GetMarketPriceRequest{} }
 func (m *GetMarketPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMarketPriceRequest) ProtoMessage()    {}
 func (*GetMarketPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{24}
+	return fileDescriptor_model_68779e9206c79477, []int{24}
 }
 func (m *GetMarketPriceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMarketPriceRequest.Unmarshal(m, b)
@@ -1563,7 +1563,7 @@ func (m *GetMarketPriceResponse) Reset()         { *m = GetMarketPriceResponse{}
 func (m *GetMarketPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMarketPriceResponse) ProtoMessage()    {}
 func (*GetMarketPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{25}
+	return fileDescriptor_model_68779e9206c79477, []int{25}
 }
 func (m *GetMarketPriceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMarketPriceResponse.Unmarshal(m, b)
@@ -1597,6 +1597,297 @@ func (m *GetMarketPriceResponse) GetPrices() map[int64]*MarketPrice {
 	return nil
 }
 
+// MarketHistory describes a single day of trading for a type in a region.
+type MarketHistory struct {
+	Date    *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date" json:"date,omitempty"`
+	Volume  int64                `protobuf:"varint,2,opt,name=volume" json:"volume,omitempty"`
+	Average float64              `protobuf:"fixed64,3,opt,name=average" json:"average,omitempty"`
+	Highest float64              `protobuf:"fixed64,4,opt,name=highest" json:"highest,omitempty"`
+	Lowest  float64              `protobuf:"fixed64,5,opt,name=lowest" json:"lowest,omitempty"`
+	// Moving average of the average price, ending on this day.
+	MovingAverage float64 `protobuf:"fixed64,6,opt,name=moving_average,json=movingAverage" json:"moving_average,omitempty"`
+	// Moving average of the volume, ending on this day.
+	MovingVolume         int64    `protobuf:"varint,7,opt,name=moving_volume,json=movingVolume" json:"moving_volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketHistory) Reset()         { *m = MarketHistory{} }
+func (m *MarketHistory) String() string { return proto.CompactTextString(m) }
+func (*MarketHistory) ProtoMessage()    {}
+func (*MarketHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{26}
+}
+func (m *MarketHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketHistory.Unmarshal(m, b)
+}
+func (m *MarketHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketHistory.Marshal(b, m, deterministic)
+}
+func (dst *MarketHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketHistory.Merge(dst, src)
+}
+func (m *MarketHistory) XXX_Size() int {
+	return xxx_messageInfo_MarketHistory.Size(m)
+}
+func (m *MarketHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketHistory proto.InternalMessageInfo
+
+func (m *MarketHistory) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *MarketHistory) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *MarketHistory) GetAverage() float64 {
+	if m != nil {
+		return m.Average
+	}
+	return 0
+}
+
+func (m *MarketHistory) GetHighest() float64 {
+	if m != nil {
+		return m.Highest
+	}
+	return 0
+}
+
+func (m *MarketHistory) GetLowest() float64 {
+	if m != nil {
+		return m.Lowest
+	}
+	return 0
+}
+
+func (m *MarketHistory) GetMovingAverage() float64 {
+	if m != nil {
+		return m.MovingAverage
+	}
+	return 0
+}
+
+func (m *MarketHistory) GetMovingVolume() int64 {
+	if m != nil {
+		return m.MovingVolume
+	}
+	return 0
+}
+
+// MarketTrend describes the movement of a type's price and volume over time.
+type MarketTrend struct {
+	RegionId int64 `protobuf:"varint,1,opt,name=region_id,json=regionId" json:"region_id,omitempty"`
+	TypeId   int64 `protobuf:"varint,2,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// Number of days in each moving average.
+	Window int32 `protobuf:"varint,3,opt,name=window" json:"window,omitempty"`
+	// Daily history, oldest first.
+	History []*MarketHistory `protobuf:"bytes,4,rep,name=history" json:"history,omitempty"`
+	// Relative change in average daily volume between the last window and
+	// the window before it.
+	VolumeTrend float64 `protobuf:"fixed64,5,opt,name=volume_trend,json=volumeTrend" json:"volume_trend,omitempty"`
+	// Standard deviation of the daily change in average price.
+	Volatility           float64  `protobuf:"fixed64,6,opt,name=volatility" json:"volatility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketTrend) Reset()         { *m = MarketTrend{} }
+func (m *MarketTrend) String() string { return proto.CompactTextString(m) }
+func (*MarketTrend) ProtoMessage()    {}
+func (*MarketTrend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{27}
+}
+func (m *MarketTrend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketTrend.Unmarshal(m, b)
+}
+func (m *MarketTrend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketTrend.Marshal(b, m, deterministic)
+}
+func (dst *MarketTrend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTrend.Merge(dst, src)
+}
+func (m *MarketTrend) XXX_Size() int {
+	return xxx_messageInfo_MarketTrend.Size(m)
+}
+func (m *MarketTrend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTrend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTrend proto.InternalMessageInfo
+
+func (m *MarketTrend) GetRegionId() int64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *MarketTrend) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *MarketTrend) GetWindow() int32 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *MarketTrend) GetHistory() []*MarketHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *MarketTrend) GetVolumeTrend() float64 {
+	if m != nil {
+		return m.VolumeTrend
+	}
+	return 0
+}
+
+func (m *MarketTrend) GetVolatility() float64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+type GetMarketHistoryRequest struct {
+	Token    *Token `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	RegionId int64  `protobuf:"varint,2,opt,name=region_id,json=regionId" json:"region_id,omitempty"`
+	TypeId   int64  `protobuf:"varint,3,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	// Number of days of history to return.
+	Days int32 `protobuf:"varint,4,opt,name=days" json:"days,omitempty"`
+	// Number of days in each moving average.
+	Window               int32    `protobuf:"varint,5,opt,name=window" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMarketHistoryRequest) Reset()         { *m = GetMarketHistoryRequest{} }
+func (m *GetMarketHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMarketHistoryRequest) ProtoMessage()    {}
+func (*GetMarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{28}
+}
+func (m *GetMarketHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMarketHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetMarketHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMarketHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMarketHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarketHistoryRequest.Merge(dst, src)
+}
+func (m *GetMarketHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMarketHistoryRequest.Size(m)
+}
+func (m *GetMarketHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarketHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMarketHistoryRequest proto.InternalMessageInfo
+
+func (m *GetMarketHistoryRequest) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *GetMarketHistoryRequest) GetRegionId() int64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *GetMarketHistoryRequest) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *GetMarketHistoryRequest) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *GetMarketHistoryRequest) GetWindow() int32 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type GetMarketHistoryResponse struct {
+	Result               *Result      `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Trend                *MarketTrend `protobuf:"bytes,2,opt,name=trend" json:"trend,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetMarketHistoryResponse) Reset()         { *m = GetMarketHistoryResponse{} }
+func (m *GetMarketHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMarketHistoryResponse) ProtoMessage()    {}
+func (*GetMarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{29}
+}
+func (m *GetMarketHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMarketHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetMarketHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMarketHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMarketHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarketHistoryResponse.Merge(dst, src)
+}
+func (m *GetMarketHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMarketHistoryResponse.Size(m)
+}
+func (m *GetMarketHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarketHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMarketHistoryResponse proto.InternalMessageInfo
+
+func (m *GetMarketHistoryResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetMarketHistoryResponse) GetTrend() *MarketTrend {
+	if m != nil {
+		return m.Trend
+	}
+	return nil
+}
+
 // Blueprint describes the necessary materials for producting an item.
 type Blueprint struct {
 	ItemId               int64          `protobuf:"varint,1,opt,name=item_id,json=itemId" json:"item_id,omitempty"`
@@ -1618,7 +1909,7 @@ func (m *Blueprint) Reset()         { *m = Blueprint{} }
 func (m *Blueprint) String() string { return proto.CompactTextString(m) }
 func (*Blueprint) ProtoMessage()    {}
 func (*Blueprint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{26}
+	return fileDescriptor_model_68779e9206c79477, []int{30}
 }
 func (m *Blueprint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Blueprint.Unmarshal(m, b)
@@ -1719,7 +2010,7 @@ func (m *GetCorpBlueprintsRequest) Reset()         { *m = GetCorpBlueprintsReque
 func (m *GetCorpBlueprintsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCorpBlueprintsRequest) ProtoMessage()    {}
 func (*GetCorpBlueprintsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{27}
+	return fileDescriptor_model_68779e9206c79477, []int{31}
 }
 func (m *GetCorpBlueprintsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCorpBlueprintsRequest.Unmarshal(m, b)
@@ -1758,7 +2049,7 @@ func (m *GetCorpBlueprintsResponse) Reset()         { *m = GetCorpBlueprintsResp
 func (m *GetCorpBlueprintsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCorpBlueprintsResponse) ProtoMessage()    {}
 func (*GetCorpBlueprintsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{28}
+	return fileDescriptor_model_68779e9206c79477, []int{32}
 }
 func (m *GetCorpBlueprintsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCorpBlueprintsResponse.Unmarshal(m, b)
@@ -1808,7 +2099,7 @@ func (m *InventoryItem) Reset()         { *m = InventoryItem{} }
 func (m *InventoryItem) String() string { return proto.CompactTextString(m) }
 func (*InventoryItem) ProtoMessage()    {}
 func (*InventoryItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{29}
+	return fileDescriptor_model_68779e9206c79477, []int{33}
 }
 func (m *InventoryItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InventoryItem.Unmarshal(m, b)
@@ -1874,7 +2165,7 @@ func (m *GetInventoryRequest) Reset()         { *m = GetInventoryRequest{} }
 func (m *GetInventoryRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInventoryRequest) ProtoMessage()    {}
 func (*GetInventoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{30}
+	return fileDescriptor_model_68779e9206c79477, []int{34}
 }
 func (m *GetInventoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInventoryRequest.Unmarshal(m, b)
@@ -1913,7 +2204,7 @@ func (m *GetInventoryResponse) Reset()         { *m = GetInventoryResponse{} }
 func (m *GetInventoryResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInventoryResponse) ProtoMessage()    {}
 func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{31}
+	return fileDescriptor_model_68779e9206c79477, []int{35}
 }
 func (m *GetInventoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInventoryResponse.Unmarshal(m, b)
@@ -1960,7 +2251,7 @@ func (m *NewInventoryItemRequest) Reset()         { *m = NewInventoryItemRequest
 func (m *NewInventoryItemRequest) String() string { return proto.CompactTextString(m) }
 func (*NewInventoryItemRequest) ProtoMessage()    {}
 func (*NewInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{32}
+	return fileDescriptor_model_68779e9206c79477, []int{36}
 }
 func (m *NewInventoryItemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewInventoryItemRequest.Unmarshal(m, b)
@@ -2013,7 +2304,7 @@ func (m *SaveInventoryItemRequest) Reset()         { *m = SaveInventoryItemReque
 func (m *SaveInventoryItemRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveInventoryItemRequest) ProtoMessage()    {}
 func (*SaveInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{33}
+	return fileDescriptor_model_68779e9206c79477, []int{37}
 }
 func (m *SaveInventoryItemRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SaveInventoryItemRequest.Unmarshal(m, b)
@@ -2059,7 +2350,7 @@ func (m *InventoryItemResponse) Reset()         { *m = InventoryItemResponse{} }
 func (m *InventoryItemResponse) String() string { return proto.CompactTextString(m) }
 func (*InventoryItemResponse) ProtoMessage()    {}
 func (*InventoryItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{34}
+	return fileDescriptor_model_68779e9206c79477, []int{38}
 }
 func (m *InventoryItemResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InventoryItemResponse.Unmarshal(m, b)
@@ -2110,7 +2401,7 @@ func (m *Location) Reset()         { *m = Location{} }
 func (m *Location) String() string { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()    {}
 func (*Location) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{35}
+	return fileDescriptor_model_68779e9206c79477, []int{39}
 }
 func (m *Location) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Location.Unmarshal(m, b)
@@ -2184,7 +2475,7 @@ func (m *GetLocationRequest) Reset()         { *m = GetLocationRequest{} }
 func (m *GetLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLocationRequest) ProtoMessage()    {}
 func (*GetLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{36}
+	return fileDescriptor_model_68779e9206c79477, []int{40}
 }
 func (m *GetLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLocationRequest.Unmarshal(m, b)
@@ -2230,7 +2521,7 @@ func (m *LocationResponse) Reset()         { *m = LocationResponse{} }
 func (m *LocationResponse) String() string { return proto.CompactTextString(m) }
 func (*LocationResponse) ProtoMessage()    {}
 func (*LocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{37}
+	return fileDescriptor_model_68779e9206c79477, []int{41}
 }
 func (m *LocationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocationResponse.Unmarshal(m, b)
@@ -2276,7 +2567,7 @@ func (m *QueryLocationsRequest) Reset()         { *m = QueryLocationsRequest{} }
 func (m *QueryLocationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLocationsRequest) ProtoMessage()    {}
 func (*QueryLocationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{38}
+	return fileDescriptor_model_68779e9206c79477, []int{42}
 }
 func (m *QueryLocationsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryLocationsRequest.Unmarshal(m, b)
@@ -2322,7 +2613,7 @@ func (m *LocationsResponse) Reset()         { *m = LocationsResponse{} }
 func (m *LocationsResponse) String() string { return proto.CompactTextString(m) }
 func (*LocationsResponse) ProtoMessage()    {}
 func (*LocationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_8d1d2e631be0431c, []int{39}
+	return fileDescriptor_model_68779e9206c79477, []int{43}
 }
 func (m *LocationsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocationsResponse.Unmarshal(m, b)
@@ -2356,6 +2647,406 @@ func (m *LocationsResponse) GetLocation() []*Location {
 	return nil
 }
 
+// ReprocessSkills are the skill levels of a character that affect their reprocessing yield.
+type ReprocessSkills struct {
+	Reprocessing           int32 `protobuf:"varint,1,opt,name=reprocessing" json:"reprocessing,omitempty"`
+	ReprocessingEfficiency int32 `protobuf:"varint,2,opt,name=reprocessing_efficiency,json=reprocessingEfficiency" json:"reprocessing_efficiency,omitempty"`
+	// Level of the processing skill for the specific ore or ice being reprocessed.
+	OreProcessing        int32    `protobuf:"varint,3,opt,name=ore_processing,json=oreProcessing" json:"ore_processing,omitempty"`
+	ScrapmetalProcessing int32    `protobuf:"varint,4,opt,name=scrapmetal_processing,json=scrapmetalProcessing" json:"scrapmetal_processing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessSkills) Reset()         { *m = ReprocessSkills{} }
+func (m *ReprocessSkills) String() string { return proto.CompactTextString(m) }
+func (*ReprocessSkills) ProtoMessage()    {}
+func (*ReprocessSkills) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{44}
+}
+func (m *ReprocessSkills) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessSkills.Unmarshal(m, b)
+}
+func (m *ReprocessSkills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessSkills.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessSkills) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessSkills.Merge(dst, src)
+}
+func (m *ReprocessSkills) XXX_Size() int {
+	return xxx_messageInfo_ReprocessSkills.Size(m)
+}
+func (m *ReprocessSkills) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessSkills.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessSkills proto.InternalMessageInfo
+
+func (m *ReprocessSkills) GetReprocessing() int32 {
+	if m != nil {
+		return m.Reprocessing
+	}
+	return 0
+}
+
+func (m *ReprocessSkills) GetReprocessingEfficiency() int32 {
+	if m != nil {
+		return m.ReprocessingEfficiency
+	}
+	return 0
+}
+
+func (m *ReprocessSkills) GetOreProcessing() int32 {
+	if m != nil {
+		return m.OreProcessing
+	}
+	return 0
+}
+
+func (m *ReprocessSkills) GetScrapmetalProcessing() int32 {
+	if m != nil {
+		return m.ScrapmetalProcessing
+	}
+	return 0
+}
+
+// ReprocessParams describe where and by whom items are reprocessed.
+type ReprocessParams struct {
+	// Base yield of the station or structure, between 0 and 1.
+	BaseYield float64          `protobuf:"fixed64,1,opt,name=base_yield,json=baseYield" json:"base_yield,omitempty"`
+	Skills    *ReprocessSkills `protobuf:"bytes,2,opt,name=skills" json:"skills,omitempty"`
+	// Bonus from a reprocessing implant, such as 0.04 for 4%.
+	Implant float64 `protobuf:"fixed64,3,opt,name=implant" json:"implant,omitempty"`
+	// Fraction of the resulting materials kept by the station owner.
+	Tax                  float64  `protobuf:"fixed64,4,opt,name=tax" json:"tax,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessParams) Reset()         { *m = ReprocessParams{} }
+func (m *ReprocessParams) String() string { return proto.CompactTextString(m) }
+func (*ReprocessParams) ProtoMessage()    {}
+func (*ReprocessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{45}
+}
+func (m *ReprocessParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessParams.Unmarshal(m, b)
+}
+func (m *ReprocessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessParams.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessParams.Merge(dst, src)
+}
+func (m *ReprocessParams) XXX_Size() int {
+	return xxx_messageInfo_ReprocessParams.Size(m)
+}
+func (m *ReprocessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessParams proto.InternalMessageInfo
+
+func (m *ReprocessParams) GetBaseYield() float64 {
+	if m != nil {
+		return m.BaseYield
+	}
+	return 0
+}
+
+func (m *ReprocessParams) GetSkills() *ReprocessSkills {
+	if m != nil {
+		return m.Skills
+	}
+	return nil
+}
+
+func (m *ReprocessParams) GetImplant() float64 {
+	if m != nil {
+		return m.Implant
+	}
+	return 0
+}
+
+func (m *ReprocessParams) GetTax() float64 {
+	if m != nil {
+		return m.Tax
+	}
+	return 0
+}
+
+// A ReprocessItem is a type and quantity of an item.
+type ReprocessItem struct {
+	TypeId               int64    `protobuf:"varint,1,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	Quantity             int64    `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessItem) Reset()         { *m = ReprocessItem{} }
+func (m *ReprocessItem) String() string { return proto.CompactTextString(m) }
+func (*ReprocessItem) ProtoMessage()    {}
+func (*ReprocessItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{46}
+}
+func (m *ReprocessItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessItem.Unmarshal(m, b)
+}
+func (m *ReprocessItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessItem.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessItem.Merge(dst, src)
+}
+func (m *ReprocessItem) XXX_Size() int {
+	return xxx_messageInfo_ReprocessItem.Size(m)
+}
+func (m *ReprocessItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessItem proto.InternalMessageInfo
+
+func (m *ReprocessItem) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *ReprocessItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+// A ReprocessMaterial is a material produced by reprocessing.
+type ReprocessMaterial struct {
+	TypeId   int64 `protobuf:"varint,1,opt,name=type_id,json=typeId" json:"type_id,omitempty"`
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	// Average market price of a single unit.
+	Price float64 `protobuf:"fixed64,3,opt,name=price" json:"price,omitempty"`
+	// Value of the entire quantity.
+	Value                float64  `protobuf:"fixed64,4,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessMaterial) Reset()         { *m = ReprocessMaterial{} }
+func (m *ReprocessMaterial) String() string { return proto.CompactTextString(m) }
+func (*ReprocessMaterial) ProtoMessage()    {}
+func (*ReprocessMaterial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{47}
+}
+func (m *ReprocessMaterial) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessMaterial.Unmarshal(m, b)
+}
+func (m *ReprocessMaterial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessMaterial.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessMaterial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessMaterial.Merge(dst, src)
+}
+func (m *ReprocessMaterial) XXX_Size() int {
+	return xxx_messageInfo_ReprocessMaterial.Size(m)
+}
+func (m *ReprocessMaterial) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessMaterial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessMaterial proto.InternalMessageInfo
+
+func (m *ReprocessMaterial) GetTypeId() int64 {
+	if m != nil {
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *ReprocessMaterial) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *ReprocessMaterial) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ReprocessMaterial) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// A ReprocessResult describes the outcome of reprocessing a list of items.
+type ReprocessResult struct {
+	Material []*ReprocessMaterial `protobuf:"bytes,1,rep,name=material" json:"material,omitempty"`
+	// Items left unprocessed because they do not make up a full portion or
+	// cannot be reprocessed.
+	Leftover []*ReprocessItem `protobuf:"bytes,2,rep,name=leftover" json:"leftover,omitempty"`
+	// Total value of the materials.
+	Value                float64  `protobuf:"fixed64,3,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReprocessResult) Reset()         { *m = ReprocessResult{} }
+func (m *ReprocessResult) String() string { return proto.CompactTextString(m) }
+func (*ReprocessResult) ProtoMessage()    {}
+func (*ReprocessResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{48}
+}
+func (m *ReprocessResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessResult.Unmarshal(m, b)
+}
+func (m *ReprocessResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessResult.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessResult.Merge(dst, src)
+}
+func (m *ReprocessResult) XXX_Size() int {
+	return xxx_messageInfo_ReprocessResult.Size(m)
+}
+func (m *ReprocessResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessResult proto.InternalMessageInfo
+
+func (m *ReprocessResult) GetMaterial() []*ReprocessMaterial {
+	if m != nil {
+		return m.Material
+	}
+	return nil
+}
+
+func (m *ReprocessResult) GetLeftover() []*ReprocessItem {
+	if m != nil {
+		return m.Leftover
+	}
+	return nil
+}
+
+func (m *ReprocessResult) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ReprocessRequest struct {
+	Token                *Token           `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Item                 []*ReprocessItem `protobuf:"bytes,2,rep,name=item" json:"item,omitempty"`
+	Params               *ReprocessParams `protobuf:"bytes,3,opt,name=params" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReprocessRequest) Reset()         { *m = ReprocessRequest{} }
+func (m *ReprocessRequest) String() string { return proto.CompactTextString(m) }
+func (*ReprocessRequest) ProtoMessage()    {}
+func (*ReprocessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{49}
+}
+func (m *ReprocessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessRequest.Unmarshal(m, b)
+}
+func (m *ReprocessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessRequest.Merge(dst, src)
+}
+func (m *ReprocessRequest) XXX_Size() int {
+	return xxx_messageInfo_ReprocessRequest.Size(m)
+}
+func (m *ReprocessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessRequest proto.InternalMessageInfo
+
+func (m *ReprocessRequest) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *ReprocessRequest) GetItem() []*ReprocessItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *ReprocessRequest) GetParams() *ReprocessParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type ReprocessResponse struct {
+	Result               *Result          `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+	Reprocessed          *ReprocessResult `protobuf:"bytes,2,opt,name=reprocessed" json:"reprocessed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReprocessResponse) Reset()         { *m = ReprocessResponse{} }
+func (m *ReprocessResponse) String() string { return proto.CompactTextString(m) }
+func (*ReprocessResponse) ProtoMessage()    {}
+func (*ReprocessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_68779e9206c79477, []int{50}
+}
+func (m *ReprocessResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReprocessResponse.Unmarshal(m, b)
+}
+func (m *ReprocessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReprocessResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReprocessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReprocessResponse.Merge(dst, src)
+}
+func (m *ReprocessResponse) XXX_Size() int {
+	return xxx_messageInfo_ReprocessResponse.Size(m)
+}
+func (m *ReprocessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReprocessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReprocessResponse proto.InternalMessageInfo
+
+func (m *ReprocessResponse) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReprocessResponse) GetReprocessed() *ReprocessResult {
+	if m != nil {
+		return m.Reprocessed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Character)(nil), "motki.model.Character")
 	proto.RegisterType((*Corporation)(nil), "motki.model.Corporation")
@@ -2384,6 +3075,10 @@ func init() {
 	proto.RegisterType((*GetMarketPriceRequest)(nil), "motki.model.GetMarketPriceRequest")
 	proto.RegisterType((*GetMarketPriceResponse)(nil), "motki.model.GetMarketPriceResponse")
 	proto.RegisterMapType((map[int64]*MarketPrice)(nil), "motki.model.GetMarketPriceResponse.PricesEntry")
+	proto.RegisterType((*MarketHistory)(nil), "motki.model.MarketHistory")
+	proto.RegisterType((*MarketTrend)(nil), "motki.model.MarketTrend")
+	proto.RegisterType((*GetMarketHistoryRequest)(nil), "motki.model.GetMarketHistoryRequest")
+	proto.RegisterType((*GetMarketHistoryResponse)(nil), "motki.model.GetMarketHistoryResponse")
 	proto.RegisterType((*Blueprint)(nil), "motki.model.Blueprint")
 	proto.RegisterType((*GetCorpBlueprintsRequest)(nil), "motki.model.GetCorpBlueprintsRequest")
 	proto.RegisterType((*GetCorpBlueprintsResponse)(nil), "motki.model.GetCorpBlueprintsResponse")
@@ -2398,6 +3093,13 @@ func init() {
 	proto.RegisterType((*LocationResponse)(nil), "motki.model.LocationResponse")
 	proto.RegisterType((*QueryLocationsRequest)(nil), "motki.model.QueryLocationsRequest")
 	proto.RegisterType((*LocationsResponse)(nil), "motki.model.LocationsResponse")
+	proto.RegisterType((*ReprocessSkills)(nil), "motki.model.ReprocessSkills")
+	proto.RegisterType((*ReprocessParams)(nil), "motki.model.ReprocessParams")
+	proto.RegisterType((*ReprocessItem)(nil), "motki.model.ReprocessItem")
+	proto.RegisterType((*ReprocessMaterial)(nil), "motki.model.ReprocessMaterial")
+	proto.RegisterType((*ReprocessResult)(nil), "motki.model.ReprocessResult")
+	proto.RegisterType((*ReprocessRequest)(nil), "motki.model.ReprocessRequest")
+	proto.RegisterType((*ReprocessResponse)(nil), "motki.model.ReprocessResponse")
 	proto.RegisterEnum("motki.model.Role", Role_name, Role_value)
 	proto.RegisterEnum("motki.model.Product_Kind", Product_Kind_name, Product_Kind_value)
 	proto.RegisterEnum("motki.model.Blueprint_Kind", Blueprint_Kind_name, Blueprint_Kind_value)
@@ -2796,6 +3498,8 @@ var _ProductService_serviceDesc = grpc.ServiceDesc{
 type MarketPriceServiceClient interface {
 	// GetMarketPrice returns the current market price for a specific type.
 	GetMarketPrice(ctx context.Context, in *GetMarketPriceRequest, opts ...grpc.CallOption) (*GetMarketPriceResponse, error)
+	// GetMarketHistory returns the daily history and trend for a type in a region.
+	GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error)
 }
 
 type marketPriceServiceClient struct {
@@ -2815,10 +3519,21 @@ func (c *marketPriceServiceClient) GetMarketPrice(ctx context.Context, in *GetMa
 	return out, nil
 }
 
+func (c *marketPriceServiceClient) GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error) {
+	out := new(GetMarketHistoryResponse)
+	err := c.cc.Invoke(ctx, "/motki.model.MarketPriceService/GetMarketHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MarketPriceServiceServer is the server API for MarketPriceService service.
 type MarketPriceServiceServer interface {
 	// GetMarketPrice returns the current market price for a specific type.
 	GetMarketPrice(context.Context, *GetMarketPriceRequest) (*GetMarketPriceResponse, error)
+	// GetMarketHistory returns the daily history and trend for a type in a region.
+	GetMarketHistory(context.Context, *GetMarketHistoryRequest) (*GetMarketHistoryResponse, error)
 }
 
 func RegisterMarketPriceServiceServer(s *grpc.Server, srv MarketPriceServiceServer) {
@@ -2843,6 +3558,24 @@ func _MarketPriceService_GetMarketPrice_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MarketPriceService_GetMarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketPriceServiceServer).GetMarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.model.MarketPriceService/GetMarketHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketPriceServiceServer).GetMarketHistory(ctx, req.(*GetMarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MarketPriceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "motki.model.MarketPriceService",
 	HandlerType: (*MarketPriceServiceServer)(nil),
@@ -2851,6 +3584,10 @@ var _MarketPriceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarketPrice",
 			Handler:    _MarketPriceService_GetMarketPrice_Handler,
 		},
+		{
+			MethodName: "GetMarketHistory",
+			Handler:    _MarketPriceService_GetMarketHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
@@ -3194,143 +3931,242 @@ var _LocationService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "model.proto",
 }
 
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_8d1d2e631be0431c) }
-
-var fileDescriptor_model_8d1d2e631be0431c = []byte{
-	// 2155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x72, 0x1b, 0xc7,
-	0xd1, 0xf6, 0xe2, 0x44, 0xa0, 0x17, 0xa0, 0xc0, 0xe1, 0xc1, 0x10, 0xfc, 0x5b, 0xa2, 0x56, 0xbf,
-	0x12, 0x26, 0xae, 0x80, 0x09, 0xe2, 0xaa, 0x58, 0x4e, 0xe5, 0x40, 0xd1, 0x34, 0xb3, 0x0a, 0x4d,
-	0xd1, 0x0b, 0x2a, 0x29, 0xa5, 0x54, 0x41, 0x2d, 0x76, 0x07, 0xe4, 0x16, 0x17, 0xbb, 0xd0, 0xec,
-	0x80, 0x12, 0x7c, 0x9b, 0xf2, 0x5b, 0xe4, 0x2e, 0x95, 0x07, 0xc8, 0x4d, 0x1e, 0x21, 0x77, 0x79,
-	0x02, 0x27, 0x2f, 0x91, 0x27, 0x48, 0xcd, 0x61, 0xcf, 0x20, 0x81, 0xa5, 0x93, 0x5c, 0x01, 0xdb,
-	0xd3, 0xfd, 0x4d, 0x77, 0x4f, 0x4f, 0x1f, 0x06, 0xd4, 0x89, 0x6f, 0x63, 0xb7, 0x37, 0x25, 0x3e,
-	0xf5, 0x91, 0x3a, 0xf1, 0xe9, 0x95, 0xd3, 0xe3, 0xa4, 0xee, 0xc3, 0x0b, 0xdf, 0xbf, 0x70, 0xf1,
-	0x3e, 0x5f, 0x1a, 0xcd, 0xc6, 0xfb, 0xd4, 0x99, 0xe0, 0x80, 0x9a, 0x93, 0xa9, 0xe0, 0xee, 0x4a,
-	0x6e, 0xf9, 0x81, 0xaf, 0xb1, 0x3d, 0x12, 0x1f, 0xda, 0x5f, 0x4a, 0xd0, 0x38, 0xbc, 0x34, 0x89,
-	0x69, 0x51, 0x4c, 0xd0, 0x3a, 0x94, 0x1c, 0xbb, 0xa3, 0xec, 0x2a, 0x7b, 0x65, 0xa3, 0xe4, 0xd8,
-	0xe8, 0x09, 0xac, 0x5b, 0x3e, 0x99, 0xfa, 0xc4, 0xa4, 0x8e, 0xef, 0x0d, 0x1d, 0xbb, 0x53, 0xe2,
-	0x6b, 0xad, 0x04, 0x55, 0xb7, 0xd1, 0x43, 0x50, 0x4d, 0xd7, 0x75, 0x4c, 0xcf, 0xc2, 0x8c, 0xa7,
-	0xcc, 0x79, 0x20, 0x24, 0xe9, 0x36, 0x42, 0x50, 0xf1, 0xcc, 0x09, 0xee, 0x54, 0x76, 0x95, 0xbd,
-	0x86, 0xc1, 0xff, 0xa3, 0x47, 0xd0, 0x1c, 0xb9, 0xbe, 0x6f, 0xbb, 0x8e, 0xc7, 0xa5, 0xaa, 0xbb,
-	0xca, 0x5e, 0xd5, 0x50, 0x23, 0x9a, 0x6e, 0xa3, 0xf7, 0x61, 0x8d, 0x98, 0x02, 0xb3, 0xc6, 0x57,
-	0x6b, 0xec, 0x53, 0x6e, 0xe8, 0x59, 0x38, 0xa0, 0x64, 0xce, 0x16, 0xd7, 0xf8, 0x22, 0x84, 0x24,
-	0xdd, 0x46, 0x4f, 0x01, 0x46, 0x0e, 0xa1, 0x97, 0x43, 0xdb, 0xa4, 0xb8, 0x53, 0xdf, 0x55, 0xf6,
-	0xd4, 0x7e, 0xb7, 0x27, 0xdc, 0xd4, 0x0b, 0xdd, 0xd4, 0x3b, 0x0f, 0xdd, 0x64, 0x34, 0x38, 0xf7,
-	0x67, 0x26, 0xc5, 0x68, 0x17, 0x54, 0x1b, 0x07, 0x16, 0x71, 0xa6, 0xcc, 0xba, 0x4e, 0x83, 0xab,
-	0x9c, 0x24, 0x69, 0x7f, 0x57, 0x40, 0x3d, 0x8c, 0x1d, 0x90, 0xf3, 0x5a, 0xc6, 0x1d, 0xa5, 0x1b,
-	0xdd, 0x51, 0x4e, 0xb8, 0xe3, 0x17, 0xd0, 0xb2, 0x08, 0x16, 0x7e, 0xe6, 0x4a, 0x57, 0x96, 0x2a,
-	0xdd, 0x0c, 0x05, 0x16, 0xe9, 0x5d, 0xcd, 0xe9, 0x8d, 0x76, 0xa0, 0x46, 0x1d, 0xeb, 0x0a, 0x13,
-	0xee, 0xcd, 0x86, 0x21, 0xbf, 0xb4, 0xaf, 0x15, 0xa8, 0x1f, 0x48, 0xed, 0x72, 0xc6, 0x84, 0xba,
-	0x96, 0x12, 0xba, 0xfe, 0x0c, 0x9a, 0x4c, 0xc5, 0xe1, 0xd8, 0x9f, 0x79, 0x36, 0x16, 0x07, 0x7e,
-	0xbb, 0xaa, 0x2a, 0xe3, 0xff, 0x5c, 0xb0, 0x27, 0xf4, 0xa8, 0xa4, 0xf4, 0xc0, 0xd0, 0x18, 0x50,
-	0x32, 0xb3, 0xe8, 0x8c, 0xac, 0xa6, 0xc7, 0x07, 0xd0, 0x08, 0xe6, 0x01, 0xc5, 0x93, 0x38, 0xea,
-	0xea, 0x82, 0x20, 0x82, 0x87, 0xce, 0xa7, 0xfc, 0x04, 0x2a, 0x7c, 0xa9, 0xc6, 0x3e, 0x75, 0x5b,
-	0xfb, 0x57, 0x15, 0xb6, 0x12, 0xc7, 0xf7, 0x3f, 0xd8, 0x12, 0x7d, 0x08, 0x30, 0x25, 0xfe, 0xd8,
-	0x71, 0xa3, 0x48, 0x2f, 0x1b, 0x0d, 0x49, 0xd1, 0x6d, 0xd4, 0x85, 0x7a, 0x80, 0xc9, 0xb5, 0x63,
-	0xe1, 0xa0, 0x53, 0xdb, 0x2d, 0xef, 0x35, 0x8c, 0xe8, 0x9b, 0xf9, 0x7a, 0x3c, 0xc3, 0xee, 0x10,
-	0xbf, 0x9b, 0x3a, 0x04, 0x07, 0x3c, 0xd6, 0x97, 0xf8, 0x9a, 0xf1, 0x1f, 0x09, 0x76, 0xf4, 0x53,
-	0x50, 0x03, 0xca, 0xce, 0x2a, 0xa0, 0x26, 0xa1, 0x2b, 0xdc, 0x04, 0xe0, 0xec, 0x03, 0xc6, 0x8d,
-	0x7e, 0x02, 0x0d, 0x21, 0x8c, 0x3d, 0x9b, 0x5f, 0x84, 0xdb, 0x45, 0xeb, 0x9c, 0xf9, 0xc8, 0xb3,
-	0x99, 0xd2, 0x33, 0xcf, 0xf4, 0xac, 0x4b, 0x9f, 0x04, 0x43, 0x93, 0x76, 0x60, 0xb9, 0xd2, 0x11,
-	0xff, 0x01, 0x45, 0x5b, 0x50, 0xe5, 0x50, 0x9d, 0x16, 0xf7, 0xbc, 0xf8, 0x40, 0x1f, 0xc1, 0x06,
-	0xc1, 0x8e, 0x37, 0xf6, 0x89, 0x85, 0x87, 0x6f, 0x31, 0xbe, 0xb2, 0xcd, 0x79, 0x67, 0x9d, 0x5f,
-	0xfd, 0x76, 0xb4, 0xf0, 0x5b, 0x41, 0x67, 0x99, 0x2b, 0x66, 0xbe, 0xf4, 0x67, 0xa4, 0x73, 0x8f,
-	0x73, 0xb6, 0x22, 0xea, 0xaf, 0xfc, 0x19, 0x41, 0x1f, 0xc3, 0x8e, 0x87, 0xdf, 0xd1, 0x61, 0x1e,
-	0xb8, 0xcd, 0xd9, 0xb7, 0xd8, 0xaa, 0x91, 0x05, 0xef, 0xc1, 0x66, 0x46, 0x8a, 0xef, 0xb0, 0xc1,
-	0x45, 0x36, 0x52, 0x22, 0x7c, 0x97, 0xe7, 0x39, 0x7e, 0x96, 0xa0, 0x3b, 0x68, 0xa9, 0x57, 0xd2,
-	0x58, 0x8c, 0xfe, 0xbc, 0x52, 0x57, 0xdb, 0xcd, 0xe7, 0x95, 0x7a, 0xb3, 0xdd, 0x32, 0xb6, 0xaf,
-	0x67, 0xae, 0x87, 0x89, 0x39, 0x72, 0x5c, 0x87, 0xce, 0x43, 0xd5, 0x0d, 0x94, 0x26, 0x33, 0xdd,
-	0xb4, 0x3f, 0x28, 0xb0, 0x79, 0x8c, 0x69, 0x94, 0xea, 0x0d, 0xfc, 0x66, 0x86, 0x03, 0x8a, 0x34,
-	0xa8, 0x52, 0xff, 0x0a, 0x7b, 0x3c, 0xec, 0xd5, 0x7e, 0xb3, 0x27, 0x2a, 0xc5, 0x39, 0xa3, 0x19,
-	0x62, 0x09, 0x3d, 0x81, 0x0a, 0xf1, 0x5d, 0x71, 0x0f, 0xd6, 0xfb, 0x1b, 0xbd, 0x44, 0xe9, 0xe9,
-	0x19, 0xbe, 0x8b, 0x0d, 0xbe, 0xcc, 0x12, 0xba, 0x15, 0xc2, 0xc7, 0xb7, 0x43, 0x8d, 0x68, 0xba,
-	0xad, 0x4d, 0x61, 0x23, 0xa1, 0x41, 0x30, 0xf5, 0xbd, 0x00, 0xa3, 0x27, 0x50, 0x23, 0x38, 0x98,
-	0xb9, 0x54, 0xea, 0xd0, 0x92, 0x1b, 0x18, 0x9c, 0x68, 0xc8, 0x45, 0xf4, 0x31, 0x34, 0x22, 0x28,
-	0xae, 0x8a, 0xda, 0xdf, 0x49, 0xa9, 0x12, 0x23, 0xc7, 0x8c, 0xda, 0x08, 0xb6, 0x99, 0xd9, 0xf1,
-	0x75, 0x2f, 0x66, 0xf8, 0x2a, 0xe5, 0x4f, 0x7b, 0x07, 0x9b, 0xa9, 0x0d, 0x8a, 0xd9, 0xf5, 0x29,
-	0xa8, 0x09, 0x38, 0x69, 0x59, 0x27, 0x6d, 0x59, 0x02, 0x3d, 0xc9, 0xac, 0xbd, 0x02, 0x74, 0x8c,
-	0x69, 0x98, 0xbb, 0x8b, 0x98, 0xb6, 0xac, 0x46, 0x69, 0x2e, 0xb4, 0x63, 0xdc, 0x62, 0x16, 0xfd,
-	0x08, 0xea, 0x21, 0x90, 0x34, 0x67, 0x3b, 0x65, 0x4e, 0x84, 0x1b, 0xb1, 0x69, 0xaf, 0x79, 0x74,
-	0x46, 0xa9, 0xb8, 0x88, 0x25, 0x8f, 0xa0, 0x19, 0x84, 0x72, 0xb1, 0x29, 0x6a, 0x44, 0xd3, 0x6d,
-	0x2d, 0x80, 0xad, 0x34, 0x7a, 0xe1, 0xc8, 0x8b, 0xd0, 0x16, 0x46, 0x5e, 0x8c, 0x1c, 0x33, 0x6a,
-	0x3f, 0x87, 0x8e, 0x8c, 0xbc, 0x68, 0x39, 0x28, 0x60, 0x17, 0xab, 0xca, 0xf7, 0x17, 0x00, 0x14,
-	0x53, 0xfd, 0x00, 0x20, 0xd2, 0x28, 0xe8, 0x94, 0x76, 0xcb, 0x7b, 0x6a, 0xff, 0xd1, 0x4d, 0xb1,
-	0x15, 0x9b, 0x91, 0x10, 0xd2, 0xbe, 0x2e, 0xc3, 0xda, 0x19, 0xf1, 0xed, 0x99, 0x45, 0x13, 0x15,
-	0xb2, 0xca, 0x2b, 0x64, 0xa2, 0xe0, 0x95, 0x52, 0x05, 0xaf, 0x0b, 0xf5, 0x37, 0x33, 0xd3, 0xa3,
-	0x0e, 0x9d, 0xf3, 0x3c, 0x50, 0x35, 0xa2, 0x6f, 0x76, 0x60, 0x13, 0x93, 0x5c, 0x61, 0x3a, 0x9c,
-	0x12, 0xc7, 0x12, 0x8d, 0x8e, 0x62, 0xa8, 0x82, 0x76, 0xc6, 0x48, 0x68, 0x0f, 0xda, 0x92, 0x85,
-	0xe0, 0x0b, 0x79, 0xf5, 0x44, 0x7f, 0xb8, 0x2e, 0xe8, 0x06, 0x27, 0xeb, 0x36, 0xda, 0x87, 0xcd,
-	0x89, 0x49, 0x31, 0x71, 0x4c, 0x77, 0x88, 0xc7, 0x63, 0xc7, 0x72, 0xb0, 0x67, 0xcd, 0x79, 0x83,
-	0xa3, 0x18, 0x28, 0x5c, 0x3a, 0x8a, 0x56, 0x58, 0x29, 0x1e, 0x99, 0xd4, 0xba, 0x1c, 0x06, 0xce,
-	0x57, 0x58, 0x76, 0x8e, 0x0d, 0x4e, 0x19, 0x38, 0x5f, 0x61, 0xf4, 0x03, 0xa8, 0x5c, 0x39, 0x9e,
-	0xcd, 0x0b, 0xe5, 0x7a, 0xff, 0x7e, 0xca, 0x55, 0xd2, 0x0b, 0xbd, 0x5f, 0x3b, 0x9e, 0x6d, 0x70,
-	0x36, 0xd6, 0x0e, 0x4c, 0x4d, 0x82, 0x3d, 0xca, 0x34, 0x6c, 0x08, 0x43, 0x05, 0x41, 0xb7, 0xd1,
-	0x0f, 0xa1, 0x1e, 0x2a, 0xd0, 0x01, 0xee, 0xfa, 0xad, 0x45, 0x78, 0x46, 0xc4, 0xa5, 0x75, 0xa1,
-	0xc2, 0xc0, 0xd1, 0x1a, 0x94, 0x9f, 0xbd, 0x7c, 0xd5, 0x7e, 0x0f, 0x35, 0xa0, 0xfa, 0xec, 0xa5,
-	0x7e, 0xf2, 0x59, 0x5b, 0xd1, 0x2e, 0xe1, 0x5e, 0x28, 0x50, 0x30, 0x08, 0x7a, 0xb0, 0x36, 0x15,
-	0x92, 0x32, 0x7a, 0x17, 0xab, 0x11, 0x32, 0x69, 0xc7, 0xb0, 0x71, 0xcc, 0x4e, 0x42, 0x6e, 0xb6,
-	0xfa, 0x55, 0x14, 0xe1, 0x51, 0x0a, 0xc3, 0x43, 0x3b, 0x83, 0x8d, 0x53, 0xfc, 0xf6, 0x0e, 0x40,
-	0x37, 0xc5, 0x95, 0x76, 0x09, 0x68, 0x60, 0x5e, 0xe3, 0x3b, 0x40, 0x16, 0x75, 0xc2, 0x27, 0x3c,
-	0xb5, 0x4a, 0x72, 0xa1, 0x8b, 0x3b, 0x85, 0xee, 0xcb, 0x29, 0xeb, 0x77, 0xa5, 0x30, 0x0f, 0xe9,
-	0xe0, 0xbf, 0xa9, 0xab, 0x03, 0xed, 0x58, 0xd1, 0x6f, 0x11, 0x1b, 0xe5, 0xe5, 0x5b, 0x9d, 0x83,
-	0xfa, 0x45, 0xe2, 0xa2, 0x26, 0x0e, 0x4a, 0x49, 0x25, 0x80, 0x0e, 0xac, 0x99, 0xd7, 0x98, 0x98,
-	0x17, 0x22, 0x63, 0x2a, 0x46, 0xf8, 0xc9, 0xba, 0xea, 0x91, 0x19, 0x88, 0xe1, 0x47, 0x31, 0xf8,
-	0x7f, 0xed, 0x9c, 0x57, 0xe9, 0x04, 0xf0, 0x9d, 0x83, 0xa5, 0x9c, 0x08, 0x96, 0x7f, 0x2a, 0xb0,
-	0x93, 0x85, 0x2d, 0xe6, 0x9d, 0x63, 0xa8, 0xf1, 0x1c, 0x15, 0xa6, 0xce, 0xfd, 0x94, 0x73, 0x16,
-	0x63, 0xf7, 0xc4, 0x79, 0x1f, 0x79, 0x94, 0xcc, 0x0d, 0x29, 0xde, 0x1d, 0x80, 0x9a, 0x20, 0xa3,
-	0x36, 0x94, 0xaf, 0xf0, 0x5c, 0xba, 0x8c, 0xfd, 0x45, 0x3d, 0xa8, 0x5e, 0x9b, 0xee, 0x0c, 0x2f,
-	0xac, 0xff, 0xc9, 0x5d, 0x04, 0xdb, 0xa7, 0xa5, 0x4f, 0x14, 0xed, 0x9b, 0x12, 0x34, 0x9e, 0xb9,
-	0x33, 0x3c, 0x25, 0x8e, 0x47, 0x99, 0x1b, 0x1c, 0x39, 0x97, 0xc8, 0xa3, 0x70, 0xc4, 0x54, 0xf2,
-	0x10, 0x54, 0xd7, 0xb7, 0x32, 0x2d, 0x0c, 0x84, 0xa4, 0xf4, 0xd8, 0x52, 0x4e, 0x1d, 0xe2, 0x63,
-	0x68, 0x45, 0x92, 0x63, 0xd7, 0xbc, 0x90, 0xf3, 0x5a, 0x33, 0x24, 0x7e, 0xee, 0x9a, 0x17, 0x4c,
-	0x9a, 0xad, 0x85, 0x43, 0x7a, 0xd9, 0xa8, 0xb1, 0x4f, 0xdd, 0x46, 0xf7, 0xa1, 0xce, 0xda, 0x5c,
-	0x96, 0x96, 0x79, 0x9e, 0x2d, 0x1b, 0x6b, 0xec, 0xfb, 0x68, 0x3c, 0x16, 0x25, 0x20, 0xce, 0xda,
-	0x3c, 0xdb, 0x96, 0x59, 0x09, 0x88, 0xd2, 0x35, 0xda, 0x97, 0x89, 0xb8, 0xc1, 0x13, 0xf1, 0x07,
-	0x29, 0x7f, 0x44, 0x46, 0x27, 0x53, 0x71, 0xb2, 0xe4, 0x80, 0x18, 0xcc, 0xa2, 0x92, 0x83, 0xa0,
-	0x42, 0x66, 0x5e, 0xd0, 0x51, 0x39, 0x9d, 0xff, 0xd7, 0x1e, 0xc8, 0x5c, 0xdb, 0x84, 0xfa, 0x0b,
-	0x43, 0x3f, 0xd6, 0x4f, 0x0f, 0x4e, 0xda, 0xef, 0xa1, 0x3a, 0x54, 0x0e, 0x5f, 0x9c, 0xbd, 0x6a,
-	0x2b, 0x89, 0xfa, 0x1d, 0x6d, 0x57, 0x28, 0x0d, 0xbc, 0x8b, 0xca, 0x77, 0x52, 0xbe, 0x70, 0xe7,
-	0x31, 0x0a, 0x85, 0x65, 0x08, 0xee, 0x2c, 0xf6, 0x84, 0x11, 0x33, 0x6a, 0x7f, 0x53, 0xa0, 0xa5,
-	0x7b, 0xd7, 0xd8, 0xa3, 0x3e, 0x99, 0xeb, 0x14, 0x4f, 0x6e, 0xbe, 0xa6, 0x4b, 0x63, 0xe3, 0x31,
-	0xb4, 0xac, 0x19, 0xe1, 0x15, 0xce, 0xc5, 0xd7, 0xd8, 0x95, 0x11, 0xd2, 0x94, 0xc4, 0x13, 0x46,
-	0x63, 0x55, 0x70, 0xe2, 0x78, 0x92, 0x41, 0x4c, 0xbe, 0xf5, 0x89, 0xe3, 0x89, 0xc5, 0xa7, 0x00,
-	0x63, 0x4c, 0xad, 0x4b, 0x6c, 0xb3, 0x49, 0xb0, 0xba, 0xfc, 0x29, 0x46, 0x72, 0x1f, 0x50, 0xed,
-	0x29, 0xef, 0x0a, 0x23, 0x53, 0x8a, 0x78, 0x7f, 0xc2, 0x5b, 0xbe, 0x84, 0x68, 0xd1, 0xb4, 0x58,
-	0x61, 0xb7, 0x47, 0xfa, 0xbc, 0x9b, 0xf2, 0x79, 0xca, 0xb5, 0x06, 0xe7, 0xd3, 0xde, 0xc2, 0xfb,
-	0xa7, 0xf8, 0x6d, 0x7a, 0xe5, 0x3f, 0x50, 0xef, 0xb2, 0xe7, 0x53, 0xce, 0x9e, 0x8f, 0xe6, 0x41,
-	0x87, 0x15, 0xc4, 0x3b, 0xef, 0x1c, 0x1b, 0xaa, 0xac, 0x64, 0xa8, 0x07, 0xdb, 0x99, 0xbd, 0xee,
-	0xea, 0xd8, 0xd5, 0xf6, 0xfb, 0x63, 0x09, 0xea, 0x27, 0xd2, 0xdc, 0xdc, 0x03, 0xcd, 0x47, 0x50,
-	0x13, 0x6f, 0x2f, 0xf2, 0x05, 0x6a, 0x53, 0xc2, 0x89, 0x07, 0xce, 0x01, 0x5f, 0x32, 0x24, 0x0b,
-	0xfa, 0x25, 0xb4, 0x2c, 0xdf, 0x0b, 0x28, 0x76, 0x5d, 0x31, 0x69, 0x55, 0x52, 0x2a, 0x08, 0x99,
-	0xc3, 0x24, 0x87, 0x91, 0x16, 0x60, 0xdb, 0x89, 0x76, 0x54, 0x46, 0x71, 0x7a, 0x3b, 0xd1, 0x92,
-	0x1a, 0x92, 0x85, 0x15, 0xd6, 0x80, 0x8a, 0x8d, 0x6a, 0xa9, 0x1a, 0x2e, 0x95, 0x13, 0x6b, 0x46,
-	0xc8, 0x94, 0x1e, 0x32, 0xd6, 0x56, 0x1d, 0x32, 0xc4, 0x00, 0x18, 0x3a, 0xa8, 0xe0, 0x00, 0x78,
-	0xeb, 0xcd, 0x67, 0x03, 0x60, 0x8c, 0x5b, 0x78, 0x00, 0x0c, 0x81, 0x16, 0x0e, 0x80, 0x11, 0x6e,
-	0xc4, 0xa6, 0x7d, 0x09, 0xdb, 0x5f, 0xce, 0x30, 0x99, 0x87, 0x4b, 0x85, 0xfa, 0xa5, 0x2d, 0xa8,
-	0xbe, 0x61, 0xc2, 0xf2, 0xa5, 0x4e, 0x7c, 0x68, 0x13, 0xd8, 0x48, 0xa0, 0x7d, 0x1b, 0x0b, 0xca,
-	0x2b, 0x58, 0xf0, 0xfd, 0xef, 0x41, 0xc5, 0xf0, 0x5d, 0xcc, 0x2a, 0xc8, 0xc1, 0xe9, 0x8b, 0x53,
-	0x51, 0x4b, 0x5e, 0x0e, 0x8e, 0x8c, 0xb6, 0x82, 0x5a, 0xd0, 0x38, 0x79, 0x71, 0xac, 0x0f, 0xce,
-	0xf5, 0xc3, 0x41, 0xbb, 0xd4, 0xff, 0xa6, 0x04, 0xaa, 0xee, 0x8d, 0xfd, 0x81, 0x78, 0xe4, 0x43,
-	0x67, 0xd0, 0x4c, 0xbe, 0xcd, 0xa0, 0xdd, 0x6c, 0x9b, 0x91, 0x7d, 0xb6, 0xe9, 0x3e, 0xb8, 0xe1,
-	0xe5, 0x23, 0x34, 0xf3, 0x37, 0xb0, 0x9e, 0x7e, 0xf6, 0x40, 0x5a, 0x0e, 0x33, 0xf7, 0x26, 0xd2,
-	0xdd, 0xbd, 0xf1, 0xd5, 0x21, 0xc4, 0xfd, 0x02, 0xd4, 0xc4, 0x83, 0x03, 0x7a, 0x98, 0x05, 0xcd,
-	0x3c, 0x45, 0x74, 0x3f, 0x5c, 0x3c, 0xf8, 0x87, 0x70, 0x03, 0x6e, 0x78, 0xfc, 0x02, 0x9b, 0x33,
-	0x3c, 0xfb, 0x22, 0xd0, 0x7d, 0x74, 0x0b, 0x87, 0x00, 0xed, 0xff, 0xa9, 0x0c, 0xeb, 0xb2, 0x6f,
-	0x0d, 0x1d, 0x2c, 0xd4, 0x0e, 0x7b, 0xe4, 0xbc, 0xda, 0x99, 0x36, 0x3f, 0xa3, 0x76, 0xae, 0xb7,
-	0x7e, 0x0e, 0x10, 0x0b, 0xa1, 0x07, 0x37, 0xa0, 0x85, 0x60, 0xff, 0xb7, 0xb0, 0xa3, 0x4e, 0x60,
-	0xc5, 0x33, 0x52, 0x06, 0x2b, 0x37, 0x3c, 0x2d, 0xc1, 0x3a, 0x01, 0x35, 0x31, 0x1d, 0x65, 0xcc,
-	0xcc, 0xcf, 0x4d, 0x4b, 0xd0, 0x5e, 0xc3, 0xe6, 0x82, 0x39, 0x06, 0x7d, 0x37, 0x25, 0x74, 0xf3,
-	0xa4, 0x73, 0x3b, 0x7a, 0xdf, 0x07, 0x94, 0x68, 0x6b, 0xc3, 0x83, 0x7a, 0xc5, 0xe3, 0x36, 0x39,
-	0x61, 0x68, 0xb7, 0xb6, 0xdc, 0x62, 0xa7, 0xc7, 0x2b, 0xb4, 0xe5, 0xfd, 0x7f, 0x28, 0x80, 0x92,
-	0x8f, 0x1d, 0x72, 0xc7, 0x11, 0x1f, 0x76, 0xd3, 0xaf, 0x2c, 0xe8, 0xc9, 0xa2, 0xcb, 0x92, 0x7b,
-	0xc6, 0xe9, 0x7e, 0x67, 0x19, 0x9b, 0xf4, 0x64, 0xbc, 0x47, 0xdc, 0x0a, 0x2e, 0xde, 0x23, 0xd7,
-	0x6a, 0x2e, 0xde, 0x23, 0xdf, 0x51, 0xf6, 0xff, 0x5c, 0x82, 0x76, 0x54, 0x40, 0x43, 0xe3, 0xc4,
-	0xfd, 0x8a, 0xc8, 0xf9, 0xfb, 0x95, 0xed, 0xad, 0xf2, 0xf7, 0x2b, 0xdf, 0x42, 0xbd, 0x86, 0x76,
-	0xb6, 0xd7, 0x41, 0xff, 0x9f, 0x8d, 0xdb, 0x45, 0x0d, 0x49, 0x57, 0xbb, 0xa5, 0xdc, 0x87, 0xe8,
-	0xbf, 0x87, 0x8d, 0x5c, 0x43, 0x93, 0xf1, 0xd5, 0x4d, 0x0d, 0xcf, 0x2a, 0xf8, 0xfd, 0xbf, 0x2a,
-	0x70, 0x2f, 0xcc, 0xde, 0xe9, 0xf4, 0x10, 0xb5, 0x19, 0xb9, 0xf4, 0x90, 0xa9, 0xaf, 0x99, 0xf4,
-	0x90, 0xab, 0x92, 0xe7, 0xb0, 0x9e, 0xae, 0x65, 0x99, 0x20, 0x5e, 0x58, 0xe8, 0x32, 0x29, 0x3d,
-	0x57, 0xb9, 0x9e, 0xad, 0xfd, 0xae, 0x2a, 0xba, 0xe5, 0x1a, 0xff, 0xf9, 0xf1, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x99, 0x0f, 0xfb, 0x20, 0x10, 0x1e, 0x00, 0x00,
+// ReprocessingServiceClient is the client API for ReprocessingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReprocessingServiceClient interface {
+	// Reprocess returns the materials produced by reprocessing the given items.
+	Reprocess(ctx context.Context, in *ReprocessRequest, opts ...grpc.CallOption) (*ReprocessResponse, error)
+}
+
+type reprocessingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReprocessingServiceClient(cc *grpc.ClientConn) ReprocessingServiceClient {
+	return &reprocessingServiceClient{cc}
+}
+
+func (c *reprocessingServiceClient) Reprocess(ctx context.Context, in *ReprocessRequest, opts ...grpc.CallOption) (*ReprocessResponse, error) {
+	out := new(ReprocessResponse)
+	err := c.cc.Invoke(ctx, "/motki.model.ReprocessingService/Reprocess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReprocessingServiceServer is the server API for ReprocessingService service.
+type ReprocessingServiceServer interface {
+	// Reprocess returns the materials produced by reprocessing the given items.
+	Reprocess(context.Context, *ReprocessRequest) (*ReprocessResponse, error)
+}
+
+func RegisterReprocessingServiceServer(s *grpc.Server, srv ReprocessingServiceServer) {
+	s.RegisterService(&_ReprocessingService_serviceDesc, srv)
+}
+
+func _ReprocessingService_Reprocess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReprocessingServiceServer).Reprocess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/motki.model.ReprocessingService/Reprocess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReprocessingServiceServer).Reprocess(ctx, req.(*ReprocessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReprocessingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "motki.model.ReprocessingService",
+	HandlerType: (*ReprocessingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reprocess",
+			Handler:    _ReprocessingService_Reprocess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_68779e9206c79477) }
+
+var fileDescriptor_model_68779e9206c79477 = []byte{
+	// 2688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x72, 0xdb, 0xc6,
+	0xf5, 0x0f, 0xf8, 0xcd, 0x03, 0x52, 0xa6, 0x56, 0xb2, 0x0c, 0x33, 0x89, 0x2d, 0xc3, 0x7f, 0xe7,
+	0xaf, 0x36, 0x53, 0xba, 0x55, 0x3c, 0xcd, 0x47, 0xa7, 0x69, 0x65, 0xc7, 0x51, 0xe8, 0x2a, 0xb6,
+	0x02, 0xca, 0xe9, 0xb8, 0x93, 0x29, 0x07, 0x22, 0x96, 0x12, 0x46, 0x20, 0x40, 0x03, 0x4b, 0xca,
+	0xcc, 0x6d, 0x27, 0x2f, 0xd0, 0xdb, 0x66, 0xa6, 0x17, 0x9d, 0x3e, 0x40, 0x6f, 0xfa, 0x08, 0xbd,
+	0xe8, 0x4c, 0x67, 0x7a, 0xd1, 0xbb, 0xb4, 0xcf, 0xd0, 0x99, 0x3e, 0x41, 0x67, 0xbf, 0x80, 0x05,
+	0x40, 0x8a, 0xa2, 0xd2, 0xf6, 0x8a, 0xdc, 0xb3, 0x67, 0x7f, 0x7b, 0xce, 0xd9, 0xb3, 0x7b, 0x3e,
+	0x00, 0xfa, 0x28, 0x70, 0xb0, 0xd7, 0x19, 0x87, 0x01, 0x09, 0x90, 0x3e, 0x0a, 0xc8, 0x99, 0xdb,
+	0x61, 0xa4, 0xf6, 0xed, 0x93, 0x20, 0x38, 0xf1, 0xf0, 0x7d, 0x36, 0x75, 0x3c, 0x19, 0xde, 0x27,
+	0xee, 0x08, 0x47, 0xc4, 0x1e, 0x8d, 0x39, 0x77, 0x5b, 0x70, 0x8b, 0x01, 0x9e, 0x62, 0xe7, 0x98,
+	0x0f, 0xcc, 0x3f, 0x14, 0xa0, 0xfe, 0xe8, 0xd4, 0x0e, 0xed, 0x01, 0xc1, 0x21, 0x5a, 0x83, 0x82,
+	0xeb, 0x18, 0xda, 0xb6, 0xb6, 0x53, 0xb4, 0x0a, 0xae, 0x83, 0xee, 0xc1, 0xda, 0x20, 0x08, 0xc7,
+	0x41, 0x68, 0x13, 0x37, 0xf0, 0xfb, 0xae, 0x63, 0x14, 0xd8, 0x5c, 0x53, 0xa1, 0x76, 0x1d, 0x74,
+	0x1b, 0x74, 0xdb, 0xf3, 0x5c, 0xdb, 0x1f, 0x60, 0xca, 0x53, 0x64, 0x3c, 0x20, 0x49, 0x5d, 0x07,
+	0x21, 0x28, 0xf9, 0xf6, 0x08, 0x1b, 0xa5, 0x6d, 0x6d, 0xa7, 0x6e, 0xb1, 0xff, 0xe8, 0x0e, 0x34,
+	0x8e, 0xbd, 0x20, 0x70, 0x3c, 0xd7, 0x67, 0xab, 0xca, 0xdb, 0xda, 0x4e, 0xd9, 0xd2, 0x63, 0x5a,
+	0xd7, 0x41, 0x37, 0xa0, 0x1a, 0xda, 0x1c, 0xb3, 0xc2, 0x66, 0x2b, 0x74, 0x28, 0x36, 0xf4, 0x07,
+	0x38, 0x22, 0xe1, 0x8c, 0x4e, 0x56, 0xd9, 0x24, 0x48, 0x52, 0xd7, 0x41, 0xef, 0x03, 0x1c, 0xbb,
+	0x21, 0x39, 0xed, 0x3b, 0x36, 0xc1, 0x46, 0x6d, 0x5b, 0xdb, 0xd1, 0x77, 0xdb, 0x1d, 0x6e, 0xa6,
+	0x8e, 0x34, 0x53, 0xe7, 0x48, 0x9a, 0xc9, 0xaa, 0x33, 0xee, 0x8f, 0x6c, 0x82, 0xd1, 0x36, 0xe8,
+	0x0e, 0x8e, 0x06, 0xa1, 0x3b, 0xa6, 0xda, 0x19, 0x75, 0x26, 0xb2, 0x4a, 0x32, 0xff, 0xa2, 0x81,
+	0xfe, 0x28, 0x31, 0x40, 0xce, 0x6a, 0x19, 0x73, 0x14, 0x16, 0x9a, 0xa3, 0xa8, 0x98, 0xe3, 0x27,
+	0xd0, 0x1c, 0x84, 0x98, 0xdb, 0x99, 0x09, 0x5d, 0x5a, 0x2a, 0x74, 0x43, 0x2e, 0x98, 0x27, 0x77,
+	0x39, 0x27, 0x37, 0xda, 0x82, 0x0a, 0x71, 0x07, 0x67, 0x38, 0x64, 0xd6, 0xac, 0x5b, 0x62, 0x64,
+	0x7e, 0xa5, 0x41, 0x6d, 0x4f, 0x48, 0x97, 0x53, 0x46, 0xca, 0x5a, 0x50, 0x64, 0xfd, 0x31, 0x34,
+	0xa8, 0x88, 0xfd, 0x61, 0x30, 0xf1, 0x1d, 0xcc, 0x0f, 0xfc, 0x62, 0x51, 0x75, 0xca, 0xff, 0x31,
+	0x67, 0x57, 0xe4, 0x28, 0xa5, 0xe4, 0xc0, 0x50, 0xef, 0x91, 0x70, 0x32, 0x20, 0x93, 0xf0, 0x72,
+	0x72, 0xbc, 0x0e, 0xf5, 0x68, 0x16, 0x11, 0x3c, 0x4a, 0xbc, 0xae, 0xc6, 0x09, 0xdc, 0x79, 0xc8,
+	0x6c, 0xcc, 0x4e, 0xa0, 0xc4, 0xa6, 0x2a, 0x74, 0xd8, 0x75, 0xcc, 0x7f, 0x95, 0x61, 0x53, 0x39,
+	0xbe, 0xff, 0xc1, 0x96, 0xe8, 0x4d, 0x80, 0x71, 0x18, 0x0c, 0x5d, 0x2f, 0xf6, 0xf4, 0xa2, 0x55,
+	0x17, 0x94, 0xae, 0x83, 0xda, 0x50, 0x8b, 0x70, 0x38, 0x75, 0x07, 0x38, 0x32, 0x2a, 0xdb, 0xc5,
+	0x9d, 0xba, 0x15, 0x8f, 0xa9, 0xad, 0x87, 0x13, 0xec, 0xf5, 0xf1, 0xab, 0xb1, 0x1b, 0xe2, 0xc8,
+	0xa8, 0x2e, 0xb7, 0x35, 0xe5, 0x7f, 0xcc, 0xd9, 0xd1, 0x8f, 0x40, 0x8f, 0x08, 0x3d, 0xab, 0x88,
+	0xd8, 0x21, 0xb9, 0xc4, 0x4d, 0x00, 0xc6, 0xde, 0xa3, 0xdc, 0xe8, 0x5d, 0xa8, 0xf3, 0xc5, 0xd8,
+	0x77, 0x8c, 0xfa, 0xd2, 0xa5, 0x35, 0xc6, 0xfc, 0xd8, 0x77, 0xa8, 0xd0, 0x13, 0xdf, 0xf6, 0x07,
+	0xa7, 0x41, 0x18, 0xf5, 0x6d, 0x62, 0xc0, 0x72, 0xa1, 0x63, 0xfe, 0x3d, 0x82, 0x36, 0xa1, 0xcc,
+	0xa0, 0x8c, 0x26, 0xb3, 0x3c, 0x1f, 0xa0, 0xb7, 0x61, 0x3d, 0xc4, 0xae, 0x3f, 0x0c, 0xc2, 0x01,
+	0xee, 0x9f, 0x63, 0x7c, 0xe6, 0xd8, 0x33, 0x63, 0x8d, 0x5d, 0xfd, 0x56, 0x3c, 0xf1, 0x73, 0x4e,
+	0xa7, 0x2f, 0x57, 0xc2, 0x7c, 0x1a, 0x4c, 0x42, 0xe3, 0x1a, 0xe3, 0x6c, 0xc6, 0xd4, 0x4f, 0x82,
+	0x49, 0x88, 0x1e, 0xc0, 0x96, 0x8f, 0x5f, 0x91, 0x7e, 0x1e, 0xb8, 0xc5, 0xd8, 0x37, 0xe9, 0xac,
+	0x95, 0x05, 0xef, 0xc0, 0x46, 0x66, 0x15, 0xdb, 0x61, 0x9d, 0x2d, 0x59, 0x4f, 0x2d, 0x61, 0xbb,
+	0x3c, 0xc9, 0xf1, 0xd3, 0x07, 0xda, 0x40, 0x4b, 0xad, 0x92, 0xc6, 0xa2, 0xf4, 0x27, 0xa5, 0x9a,
+	0xde, 0x6a, 0x3c, 0x29, 0xd5, 0x1a, 0xad, 0xa6, 0x75, 0x7d, 0x3a, 0xf1, 0x7c, 0x1c, 0xda, 0xc7,
+	0xae, 0xe7, 0x92, 0x99, 0x14, 0xdd, 0x42, 0x69, 0x32, 0x95, 0xcd, 0xfc, 0x95, 0x06, 0x1b, 0xfb,
+	0x98, 0xc4, 0x4f, 0xbd, 0x85, 0x5f, 0x4e, 0x70, 0x44, 0x90, 0x09, 0x65, 0x12, 0x9c, 0x61, 0x9f,
+	0xb9, 0xbd, 0xbe, 0xdb, 0xe8, 0xf0, 0x48, 0x71, 0x44, 0x69, 0x16, 0x9f, 0x42, 0xf7, 0xa0, 0x14,
+	0x06, 0x1e, 0xbf, 0x07, 0x6b, 0xbb, 0xeb, 0x1d, 0x25, 0xf4, 0x74, 0xac, 0xc0, 0xc3, 0x16, 0x9b,
+	0xa6, 0x0f, 0xfa, 0x40, 0xc2, 0x27, 0xb7, 0x43, 0x8f, 0x69, 0x5d, 0xc7, 0x1c, 0xc3, 0xba, 0x22,
+	0x41, 0x34, 0x0e, 0xfc, 0x08, 0xa3, 0x7b, 0x50, 0x09, 0x71, 0x34, 0xf1, 0x88, 0x90, 0xa1, 0x29,
+	0x36, 0xb0, 0x18, 0xd1, 0x12, 0x93, 0xe8, 0x01, 0xd4, 0x63, 0x28, 0x26, 0x8a, 0xbe, 0xbb, 0x95,
+	0x12, 0x25, 0x41, 0x4e, 0x18, 0xcd, 0x63, 0xb8, 0x4e, 0xd5, 0x4e, 0xae, 0xfb, 0x6a, 0x8a, 0x5f,
+	0x26, 0xfc, 0x99, 0xaf, 0x60, 0x23, 0xb5, 0xc1, 0x6a, 0x7a, 0x7d, 0x00, 0xba, 0x02, 0x27, 0x34,
+	0x33, 0xd2, 0x9a, 0x29, 0xe8, 0x2a, 0xb3, 0xf9, 0x02, 0xd0, 0x3e, 0x26, 0xf2, 0xed, 0x5e, 0x45,
+	0xb5, 0x65, 0x31, 0xca, 0xf4, 0xa0, 0x95, 0xe0, 0xae, 0xa6, 0xd1, 0x0f, 0xa0, 0x26, 0x81, 0x84,
+	0x3a, 0xd7, 0x53, 0xea, 0xc4, 0xb8, 0x31, 0x9b, 0xf9, 0x05, 0xf3, 0xce, 0xf8, 0x29, 0x5e, 0x45,
+	0x93, 0x3b, 0xd0, 0x88, 0xe4, 0xba, 0x44, 0x15, 0x3d, 0xa6, 0x75, 0x1d, 0x33, 0x82, 0xcd, 0x34,
+	0xfa, 0xca, 0x9e, 0x17, 0xa3, 0xcd, 0xf5, 0xbc, 0x04, 0x39, 0x61, 0x34, 0x3f, 0x04, 0x43, 0x78,
+	0x5e, 0x3c, 0x1d, 0xad, 0xa0, 0x17, 0x8d, 0xca, 0x37, 0xe7, 0x00, 0xac, 0x26, 0xfa, 0x1e, 0x40,
+	0x2c, 0x51, 0x64, 0x14, 0xb6, 0x8b, 0x3b, 0xfa, 0xee, 0x9d, 0x45, 0xbe, 0x95, 0xa8, 0xa1, 0x2c,
+	0x32, 0xbf, 0x2a, 0x42, 0xf5, 0x30, 0x0c, 0x9c, 0xc9, 0x80, 0x28, 0x11, 0xb2, 0xcc, 0x22, 0xa4,
+	0x12, 0xf0, 0x0a, 0xa9, 0x80, 0xd7, 0x86, 0xda, 0xcb, 0x89, 0xed, 0x13, 0x97, 0xcc, 0xd8, 0x3b,
+	0x50, 0xb6, 0xe2, 0x31, 0x3d, 0xb0, 0x91, 0x1d, 0x9e, 0x61, 0xd2, 0x1f, 0x87, 0xee, 0x80, 0x27,
+	0x3a, 0x9a, 0xa5, 0x73, 0xda, 0x21, 0x25, 0xa1, 0x1d, 0x68, 0x09, 0x96, 0x10, 0x9f, 0x88, 0xab,
+	0xc7, 0xf3, 0xc3, 0x35, 0x4e, 0xb7, 0x18, 0xb9, 0xeb, 0xa0, 0xfb, 0xb0, 0x31, 0xb2, 0x09, 0x0e,
+	0x5d, 0xdb, 0xeb, 0xe3, 0xe1, 0xd0, 0x1d, 0xb8, 0xd8, 0x1f, 0xcc, 0x58, 0x82, 0xa3, 0x59, 0x48,
+	0x4e, 0x3d, 0x8e, 0x67, 0x68, 0x28, 0x3e, 0xb6, 0xc9, 0xe0, 0xb4, 0x1f, 0xb9, 0x5f, 0x62, 0x91,
+	0x39, 0xd6, 0x19, 0xa5, 0xe7, 0x7e, 0x89, 0xd1, 0xf7, 0xa0, 0x74, 0xe6, 0xfa, 0x0e, 0x0b, 0x94,
+	0x6b, 0xbb, 0x37, 0x53, 0xa6, 0x12, 0x56, 0xe8, 0xfc, 0xcc, 0xf5, 0x1d, 0x8b, 0xb1, 0xd1, 0x74,
+	0x60, 0x6c, 0x87, 0xd8, 0x27, 0x7d, 0x97, 0x47, 0xc8, 0xb2, 0x55, 0xe3, 0x84, 0xae, 0x83, 0xbe,
+	0x0f, 0x35, 0x29, 0x80, 0x01, 0xcc, 0xf4, 0x9b, 0xf3, 0xf0, 0xac, 0x98, 0xcb, 0x6c, 0x43, 0x89,
+	0x82, 0xa3, 0x2a, 0x14, 0x1f, 0x3e, 0x7f, 0xd1, 0x7a, 0x0d, 0xd5, 0xa1, 0xfc, 0xf0, 0x79, 0xf7,
+	0xe0, 0xa3, 0x96, 0x66, 0x9e, 0xc2, 0x35, 0xb9, 0x60, 0x45, 0x27, 0xe8, 0x40, 0x75, 0xcc, 0x57,
+	0x0a, 0xef, 0x9d, 0x2f, 0x86, 0x64, 0x32, 0xf7, 0x61, 0x7d, 0x9f, 0x9e, 0x84, 0xd8, 0xec, 0xf2,
+	0x57, 0x91, 0xbb, 0x47, 0x41, 0xba, 0x87, 0x79, 0x08, 0xeb, 0x4f, 0xf1, 0xf9, 0x15, 0x80, 0x16,
+	0xf9, 0x95, 0x79, 0x0a, 0xa8, 0x67, 0x4f, 0xf1, 0x15, 0x20, 0x57, 0x35, 0xc2, 0x7b, 0xec, 0x69,
+	0x15, 0xe4, 0x95, 0x2e, 0xee, 0x18, 0xda, 0xcf, 0xc7, 0x8e, 0x4d, 0xa4, 0x94, 0xcc, 0xa5, 0xa3,
+	0xff, 0xa6, 0xac, 0x2e, 0xb4, 0x12, 0x41, 0xbf, 0x85, 0x6f, 0x14, 0x97, 0x6f, 0x75, 0x04, 0xfa,
+	0xa7, 0xca, 0x45, 0x55, 0x0e, 0x4a, 0x4b, 0x3d, 0x00, 0x06, 0x54, 0xed, 0x29, 0x0e, 0xed, 0x13,
+	0xfe, 0x62, 0x6a, 0x96, 0x1c, 0xd2, 0xac, 0xfa, 0xd8, 0x8e, 0x78, 0xf1, 0xa3, 0x59, 0xec, 0xbf,
+	0x79, 0xc4, 0xa2, 0xb4, 0x02, 0x7c, 0x65, 0x67, 0x29, 0x2a, 0xce, 0xf2, 0x0f, 0x0d, 0xb6, 0xb2,
+	0xb0, 0xab, 0x59, 0x67, 0x1f, 0x2a, 0xec, 0x8d, 0x92, 0x4f, 0xe7, 0xfd, 0x94, 0x71, 0xe6, 0x63,
+	0x77, 0xd8, 0x28, 0x7a, 0xec, 0x93, 0x70, 0x66, 0x89, 0xe5, 0xed, 0x1e, 0xe8, 0x0a, 0x19, 0xb5,
+	0xa0, 0x78, 0x86, 0x67, 0xc2, 0x64, 0xf4, 0x2f, 0xea, 0x40, 0x79, 0x6a, 0x7b, 0x13, 0x3c, 0x37,
+	0xfe, 0xab, 0xbb, 0x70, 0xb6, 0x0f, 0x0a, 0xef, 0x69, 0xe6, 0x3f, 0x35, 0x68, 0xf2, 0xa9, 0x4f,
+	0xdc, 0x88, 0x04, 0x21, 0x45, 0x29, 0xb1, 0xda, 0x51, 0x5b, 0x9a, 0x59, 0x32, 0x3e, 0x5a, 0x89,
+	0x4d, 0x03, 0x6f, 0x22, 0x6a, 0x9c, 0xa2, 0x25, 0x46, 0xea, 0xe9, 0x15, 0xd3, 0xa7, 0x67, 0x40,
+	0xf5, 0xd4, 0x3d, 0x39, 0xc5, 0x11, 0x11, 0xef, 0xb6, 0x1c, 0x52, 0x2c, 0x2f, 0x38, 0xa7, 0x13,
+	0x65, 0x36, 0x21, 0x46, 0x34, 0x89, 0x1a, 0x05, 0x53, 0xd7, 0x3f, 0xe9, 0x4b, 0x48, 0xfe, 0x38,
+	0x37, 0x39, 0x75, 0x4f, 0x00, 0xdf, 0x05, 0x41, 0xe8, 0x0b, 0x89, 0xaa, 0x4c, 0xa2, 0x06, 0x27,
+	0x7e, 0xce, 0x68, 0xe6, 0xdf, 0x34, 0xe9, 0x7e, 0x47, 0x21, 0xe6, 0xcf, 0x6f, 0x12, 0x20, 0xb8,
+	0x35, 0x6b, 0xa1, 0x0c, 0x0d, 0x0b, 0x83, 0xd3, 0x16, 0x54, 0xce, 0x5d, 0xdf, 0x09, 0xce, 0x45,
+	0x68, 0x12, 0x23, 0xf4, 0x80, 0xea, 0xc6, 0x0c, 0x69, 0x94, 0xd8, 0x71, 0xb7, 0xe7, 0x9c, 0x82,
+	0x30, 0xb5, 0x25, 0x59, 0x69, 0x38, 0xe3, 0x12, 0xf7, 0x09, 0x95, 0x49, 0x68, 0xaf, 0x73, 0x1a,
+	0x17, 0xf3, 0x16, 0xc0, 0x34, 0xf0, 0x6c, 0xc2, 0xf2, 0x71, 0xa1, 0xbe, 0x42, 0x31, 0x7f, 0xab,
+	0xc1, 0x8d, 0xd8, 0x99, 0xe4, 0x06, 0x2b, 0xdc, 0x80, 0x94, 0x19, 0x0a, 0x8b, 0xcd, 0x50, 0x4c,
+	0x99, 0x01, 0x51, 0x67, 0x99, 0x45, 0xec, 0x1c, 0xcb, 0x16, 0xfb, 0xaf, 0x98, 0xa6, 0xac, 0x9a,
+	0xc6, 0x7c, 0x09, 0x46, 0x5e, 0xc0, 0x55, 0x5f, 0x9a, 0x32, 0x37, 0xd0, 0x62, 0x0f, 0x67, 0xd6,
+	0xb2, 0x38, 0x9b, 0xf9, 0x4d, 0x01, 0xea, 0x0f, 0xbd, 0x09, 0x1e, 0x87, 0xae, 0x4f, 0xa8, 0x16,
+	0xae, 0xa8, 0xba, 0xc5, 0x43, 0xe3, 0xf2, 0x9a, 0xfb, 0x36, 0xe8, 0x5e, 0x30, 0xc8, 0x24, 0xe8,
+	0x20, 0x49, 0x17, 0xe9, 0x7f, 0x17, 0x9a, 0xf1, 0xca, 0xa1, 0x67, 0x9f, 0x88, 0x6e, 0x44, 0x43,
+	0x12, 0x3f, 0xf6, 0xec, 0x13, 0xba, 0x9a, 0xce, 0xc9, 0x16, 0x54, 0xd1, 0xaa, 0xd0, 0x61, 0xd7,
+	0x41, 0x37, 0xa1, 0x46, 0xdc, 0x11, 0xa6, 0x49, 0x87, 0x70, 0xd5, 0x2a, 0x1d, 0x3f, 0x1e, 0x0e,
+	0x79, 0x82, 0x93, 0xe4, 0x24, 0x2c, 0x97, 0x28, 0x5a, 0xba, 0xa4, 0x51, 0x96, 0xfb, 0x22, 0xcd,
+	0xa8, 0xb3, 0x34, 0xe3, 0xf5, 0x94, 0x2d, 0x62, 0xa5, 0xd5, 0x44, 0x43, 0x4d, 0xa8, 0x80, 0x9f,
+	0xb0, 0x1c, 0xd3, 0x83, 0x0c, 0x27, 0x7e, 0x64, 0xe8, 0x8c, 0xce, 0xfe, 0x9b, 0xb7, 0x44, 0x26,
+	0xd1, 0x80, 0xda, 0x33, 0xab, 0xbb, 0xdf, 0x7d, 0xba, 0x77, 0xd0, 0x7a, 0x0d, 0xd5, 0xa0, 0xf4,
+	0xe8, 0xd9, 0xe1, 0x8b, 0x96, 0xa6, 0x64, 0xa7, 0xf1, 0x76, 0x2b, 0x05, 0xb9, 0x57, 0x70, 0x73,
+	0xce, 0xfa, 0x95, 0xf3, 0xea, 0x63, 0xb9, 0x58, 0x3c, 0xb0, 0x5b, 0xf3, 0x2d, 0x61, 0x25, 0x8c,
+	0xe6, 0x9f, 0x34, 0x68, 0x76, 0xfd, 0x29, 0xf6, 0xa9, 0x13, 0x76, 0x09, 0x1e, 0x2d, 0x0e, 0x42,
+	0x4b, 0x7d, 0xe3, 0x2e, 0x34, 0x07, 0x93, 0x90, 0xe5, 0x6f, 0x1e, 0x9e, 0x62, 0x4f, 0x78, 0x48,
+	0x43, 0x10, 0x0f, 0x28, 0x8d, 0xde, 0xae, 0x91, 0xeb, 0x0b, 0x06, 0xde, 0xd7, 0xa9, 0x8d, 0x5c,
+	0x9f, 0x4f, 0xbe, 0x0f, 0x30, 0xc4, 0x64, 0x70, 0x8a, 0x1d, 0xda, 0xe7, 0x28, 0x2f, 0x6f, 0x34,
+	0x0a, 0xee, 0x3d, 0x62, 0xbe, 0xcf, 0x6a, 0x9e, 0x58, 0x95, 0x55, 0xac, 0x3f, 0x82, 0xcd, 0xf4,
+	0xd2, 0x55, 0xaf, 0x62, 0x89, 0xde, 0x1e, 0xa3, 0x30, 0xe7, 0x95, 0x4b, 0x99, 0xd6, 0x62, 0x7c,
+	0xe6, 0x39, 0xdc, 0x78, 0x8a, 0xcf, 0xd3, 0x33, 0xff, 0x81, 0x6c, 0x2e, 0x7b, 0x3e, 0xc5, 0xec,
+	0xf9, 0x98, 0x3e, 0x18, 0x34, 0xdd, 0xbb, 0xf2, 0xce, 0x89, 0xa2, 0xda, 0xa5, 0x14, 0xf5, 0xe1,
+	0x7a, 0x66, 0xaf, 0xab, 0x1a, 0xf6, 0x72, 0xfb, 0x7d, 0x5d, 0x80, 0xda, 0x81, 0x50, 0x37, 0xd7,
+	0x7e, 0x7c, 0x1b, 0x2a, 0xbc, 0xb3, 0x28, 0xfa, 0xab, 0x1b, 0x02, 0x8e, 0xb7, 0xef, 0x7b, 0x6c,
+	0xca, 0x12, 0x2c, 0xe8, 0xa7, 0xd0, 0x1c, 0x04, 0x7e, 0x44, 0xb0, 0xe7, 0x31, 0xb4, 0xb8, 0x7d,
+	0xac, 0xae, 0x79, 0xa4, 0x72, 0x58, 0xe9, 0x05, 0x74, 0x3b, 0x1e, 0x33, 0x8c, 0xf2, 0x9c, 0xed,
+	0x78, 0xc1, 0x65, 0x09, 0x16, 0x9a, 0x36, 0x46, 0x84, 0x6f, 0x54, 0x49, 0x65, 0xa8, 0x42, 0x38,
+	0x3e, 0x67, 0x49, 0xa6, 0x74, 0x09, 0x5d, 0xbd, 0x6c, 0x09, 0xcd, 0xdb, 0x1b, 0xd2, 0x40, 0x2b,
+	0xb6, 0x37, 0x2e, 0xbc, 0xf9, 0xb4, 0xbd, 0x91, 0xe0, 0xae, 0xdc, 0xde, 0x90, 0x40, 0x73, 0xdb,
+	0x1b, 0x31, 0x6e, 0xcc, 0x66, 0x7e, 0x06, 0xd7, 0x3f, 0x9b, 0xe0, 0x70, 0x26, 0xa7, 0x56, 0xaa,
+	0x06, 0x36, 0xa1, 0xfc, 0x92, 0x2e, 0x16, 0x7d, 0x68, 0x3e, 0x30, 0x47, 0xb0, 0xae, 0xa0, 0x7d,
+	0x1b, 0x0d, 0x8a, 0x97, 0xd1, 0xe0, 0xcf, 0x1a, 0x5c, 0xb3, 0xf0, 0x38, 0x0c, 0x06, 0x38, 0x8a,
+	0x7a, 0x67, 0xae, 0xe7, 0x45, 0xc8, 0x84, 0x46, 0x28, 0x49, 0xae, 0x7f, 0x22, 0xfa, 0x02, 0x29,
+	0x1a, 0x7a, 0x17, 0x6e, 0xa8, 0x63, 0xb5, 0x46, 0xe7, 0x75, 0xe2, 0x96, 0x3a, 0xad, 0xd4, 0xe9,
+	0xf7, 0x60, 0x2d, 0x08, 0x71, 0x5f, 0x81, 0xe7, 0xc9, 0x5a, 0x33, 0x08, 0xf1, 0x61, 0x4c, 0x44,
+	0xef, 0xc0, 0xf5, 0x68, 0x10, 0xda, 0xe3, 0x11, 0x26, 0xb6, 0xa7, 0x72, 0xf3, 0xac, 0x66, 0x33,
+	0x99, 0x4c, 0x16, 0x99, 0xbf, 0x56, 0x95, 0x39, 0xb4, 0x43, 0x7b, 0x14, 0xf1, 0xbe, 0x40, 0x84,
+	0xfb, 0x33, 0x17, 0x7b, 0xfc, 0x16, 0x6a, 0xb4, 0x2f, 0x10, 0xe1, 0x17, 0x94, 0x80, 0x1e, 0x40,
+	0x25, 0x62, 0x5a, 0x8b, 0x23, 0x7f, 0x23, 0xdd, 0x05, 0x4d, 0x5b, 0xc6, 0x12, 0xbc, 0x34, 0x5b,
+	0x76, 0x47, 0x63, 0xcf, 0xf6, 0x89, 0xcc, 0xa3, 0xc5, 0x90, 0x56, 0x00, 0xc4, 0x7e, 0x25, 0x72,
+	0x68, 0xfa, 0xd7, 0xfc, 0x08, 0x9a, 0x31, 0xcc, 0xc5, 0x61, 0x4d, 0xcd, 0x05, 0x0a, 0xe9, 0x5c,
+	0xc0, 0x24, 0xb0, 0x1e, 0xa3, 0x7c, 0x2a, 0x12, 0x8e, 0x2b, 0x21, 0x51, 0xb7, 0xe3, 0xfd, 0x19,
+	0x2e, 0x39, 0x1f, 0x50, 0x2a, 0xaf, 0x53, 0xb8, 0xe4, 0x7c, 0x60, 0xfe, 0x46, 0x35, 0xa8, 0x25,
+	0xfb, 0x9a, 0x49, 0xf7, 0x43, 0x63, 0x4e, 0x76, 0x6b, 0xbe, 0xcd, 0xa4, 0x98, 0x49, 0x1f, 0x04,
+	0xfd, 0x10, 0x6a, 0x1e, 0x1e, 0x92, 0x60, 0xca, 0x5a, 0xbd, 0xf9, 0x20, 0x95, 0x32, 0x94, 0x15,
+	0xf3, 0x26, 0xd2, 0x15, 0x55, 0xe9, 0xbe, 0xd6, 0xa0, 0xa5, 0x48, 0x77, 0x95, 0xf0, 0xb1, 0x4c,
+	0x04, 0xc6, 0x47, 0x9d, 0x64, 0xcc, 0xbc, 0xc9, 0x28, 0x5e, 0xe4, 0x24, 0xdc, 0xe3, 0x2c, 0xc1,
+	0x6b, 0x7e, 0xa9, 0x1c, 0xd9, 0xaa, 0x37, 0xf9, 0x43, 0xd0, 0xe3, 0xfb, 0x83, 0x9d, 0x8b, 0x7d,
+	0x53, 0x2c, 0x55, 0x17, 0x7c, 0xf7, 0x3b, 0x50, 0xb2, 0x02, 0x0f, 0xd3, 0xc4, 0x70, 0xef, 0xe9,
+	0xb3, 0xa7, 0x3c, 0x45, 0x7c, 0xde, 0x7b, 0x6c, 0xb5, 0x34, 0xd4, 0x84, 0xfa, 0xc1, 0xb3, 0xfd,
+	0x6e, 0xef, 0xa8, 0xfb, 0xa8, 0xd7, 0x2a, 0xec, 0x7e, 0x53, 0x00, 0xbd, 0xeb, 0x0f, 0x83, 0x1e,
+	0xff, 0x32, 0x85, 0x0e, 0xa1, 0xa1, 0x7e, 0x50, 0x40, 0xdb, 0xd9, 0xda, 0x38, 0xfb, 0xad, 0xa1,
+	0x7d, 0x6b, 0x41, 0xbb, 0x5e, 0xea, 0xfc, 0x39, 0xac, 0xa5, 0x7b, 0xf5, 0xc8, 0xcc, 0x61, 0xe6,
+	0x1a, 0xf9, 0xed, 0xed, 0x85, 0xad, 0x72, 0x89, 0xfb, 0x29, 0xe8, 0x4a, 0x97, 0x1c, 0xdd, 0xce,
+	0x82, 0x66, 0xfa, 0xe7, 0xed, 0x37, 0xe7, 0x77, 0xab, 0x25, 0x5c, 0x8f, 0x29, 0x9e, 0x7c, 0x36,
+	0xcc, 0x29, 0x9e, 0x6d, 0x63, 0xb7, 0xef, 0x5c, 0xc0, 0xc1, 0x41, 0x77, 0x7f, 0x57, 0x84, 0x35,
+	0xd1, 0x6c, 0x91, 0x06, 0xe6, 0x62, 0x0b, 0x62, 0x94, 0x17, 0x3b, 0xd3, 0x9b, 0xca, 0x88, 0x9d,
+	0x6b, 0x08, 0x3d, 0x01, 0x48, 0x16, 0xa1, 0x5b, 0x0b, 0xd0, 0x24, 0xd8, 0x1b, 0xf3, 0xc0, 0x54,
+	0xac, 0xa4, 0xb1, 0x97, 0xc1, 0xca, 0x75, 0xfc, 0x96, 0x60, 0x1d, 0x80, 0xae, 0xb4, 0xf4, 0x32,
+	0x6a, 0xe6, 0x9b, 0x7d, 0x4b, 0xd0, 0xbe, 0x80, 0x8d, 0x39, 0xcd, 0x37, 0xf4, 0xff, 0xa9, 0x45,
+	0x8b, 0xdb, 0x73, 0x17, 0xa3, 0xef, 0xfe, 0x55, 0x03, 0xa4, 0x34, 0x63, 0xe4, 0x49, 0xbd, 0x60,
+	0x8e, 0xab, 0x4c, 0xe4, 0x1d, 0x37, 0xdf, 0xdb, 0x6a, 0xdf, 0xbd, 0x44, 0x33, 0x09, 0xf5, 0xa1,
+	0x95, 0x2d, 0xbc, 0xd1, 0xff, 0xcd, 0x5f, 0x98, 0x6e, 0x1c, 0xb4, 0xef, 0x2d, 0xe1, 0x12, 0x2a,
+	0xfd, 0x5d, 0x03, 0xa4, 0x7e, 0x03, 0x10, 0x2a, 0x1d, 0xb3, 0x1e, 0x70, 0xfa, 0xe3, 0x03, 0xba,
+	0x37, 0xef, 0x3a, 0xe6, 0xbe, 0x6e, 0xb4, 0xdf, 0x5a, 0xc6, 0x26, 0x74, 0x4b, 0xf6, 0x48, 0x6a,
+	0xc8, 0xf9, 0x7b, 0xe4, 0x6a, 0xd4, 0xf6, 0x5b, 0xcb, 0xd8, 0x84, 0x7a, 0xbf, 0x2f, 0x40, 0x2b,
+	0xce, 0xbc, 0xa5, 0x72, 0xfc, 0x06, 0xc7, 0xe4, 0xfc, 0x0d, 0xce, 0x16, 0x65, 0xed, 0x3b, 0x17,
+	0x70, 0xc4, 0x9e, 0xd7, 0xca, 0x16, 0x49, 0x99, 0x93, 0x5a, 0x50, 0x43, 0xb5, 0xcd, 0x0b, 0xea,
+	0x04, 0x89, 0xfe, 0x4b, 0x58, 0xcf, 0x55, 0x42, 0x19, 0x5b, 0x2d, 0xaa, 0x94, 0x2e, 0x83, 0xbf,
+	0xfb, 0x47, 0x0d, 0xae, 0xc9, 0xb4, 0x2f, 0xfd, 0x00, 0x49, 0x6a, 0xfe, 0x01, 0xca, 0x24, 0xe6,
+	0x99, 0x07, 0x28, 0x97, 0x5e, 0x1f, 0xc1, 0x5a, 0x3a, 0x09, 0xce, 0xdc, 0x92, 0xb9, 0x19, 0x72,
+	0x26, 0x68, 0xe4, 0x52, 0xde, 0x5d, 0x1b, 0x36, 0x2c, 0x25, 0x83, 0x94, 0xb2, 0x3f, 0x81, 0x7a,
+	0x4c, 0x46, 0x6f, 0x2e, 0x0a, 0x88, 0xf3, 0xb6, 0xc8, 0xc5, 0xe2, 0x87, 0xd5, 0x5f, 0x94, 0x79,
+	0x25, 0x5f, 0x61, 0x3f, 0xef, 0xfc, 0x7b, 0x00, 0xee, 0x4d, 0xef, 0xa0, 0x8a, 0x25, 0x00, 0x00,
 }
@@ -20,7 +20,7 @@ func (srv *grpcServer) GetCorpBlueprints(ctx context.Context, req *proto.GetCorp
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	_, charID, err := srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, charID, err := srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +32,7 @@ func (srv *grpcServer) GetCorpBlueprints(ctx context.Context, req *proto.GetCorp
 	if err != nil {
 		return nil, err
 	}
-	bps, err := srv.model.GetCorporationBlueprints(corpAuth.Context(), char.CorporationID)
+	bps, err := srv.model.GetCorporationBlueprints(corpAuth.WithContext(ctx), char.CorporationID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,32 @@
 package server
 
 import (
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 
+	"github.com/motki/core/evedb"
 	"github.com/motki/core/proto"
 )
 
+func (srv *grpcServer) GetVersion(ctx context.Context, req *proto.GetVersionRequest) (resp *proto.GetVersionResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetVersionResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	v, err := srv.evedb.Version()
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetVersionResponse{
+		Result:  successResult,
+		Version: v,
+	}, nil
+}
+
 func (srv *grpcServer) GetRegion(ctx context.Context, req *proto.GetRegionRequest) (resp *proto.GetRegionResponse, err error) {
 	defer func() {
 		if err != nil {
@@ -295,3 +316,137 @@ func (srv *grpcServer) GetStation(ctx context.Context, req *proto.GetStationRequ
 		Station: proto.StationToProto(res),
 	}, nil
 }
+
+func (srv *grpcServer) GetRoute(ctx context.Context, req *proto.GetRouteRequest) (resp *proto.GetRouteResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetRouteResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	var avoid []int
+	for _, id := range req.AvoidId {
+		avoid = append(avoid, int(id))
+	}
+	res, err := srv.evedb.GetRoute(int(req.OriginId), int(req.DestinationId), evedb.RouteFlag(req.Flag), avoid...)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetRouteResponse{
+		Result: successResult,
+		Route:  proto.RouteToProto(res),
+	}, nil
+}
+
+func (srv *grpcServer) GetJumps(ctx context.Context, req *proto.GetJumpsRequest) (resp *proto.GetJumpsResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetJumpsResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	res, err := srv.evedb.GetJumps(int(req.OriginId), int(req.DestinationId))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetJumpsResponse{
+		Result: successResult,
+		Jumps:  int64(res),
+	}, nil
+}
+
+func (srv *grpcServer) GetIndustryBlueprint(ctx context.Context, req *proto.GetIndustryBlueprintRequest) (resp *proto.GetIndustryBlueprintResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetIndustryBlueprintResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	res, err := srv.evedb.GetIndustryBlueprint(int(req.TypeId))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetIndustryBlueprintResponse{
+		Result:    successResult,
+		Blueprint: proto.IndustryBlueprintToProto(res),
+	}, nil
+}
+
+func (srv *grpcServer) GetIndustryBlueprintByProduct(ctx context.Context, req *proto.GetIndustryBlueprintByProductRequest) (resp *proto.GetIndustryBlueprintByProductResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetIndustryBlueprintByProductResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	res, err := srv.evedb.GetIndustryBlueprintByProduct(int(req.ProductTypeId), evedb.ActivityType(req.ActivityId))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetIndustryBlueprintByProductResponse{
+		Result:    successResult,
+		Blueprint: proto.IndustryBlueprintToProto(res),
+	}, nil
+}
+
+func (srv *grpcServer) GetTypeDogma(ctx context.Context, req *proto.GetTypeDogmaRequest) (resp *proto.GetTypeDogmaResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetTypeDogmaResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	if len(req.TypeId) == 0 {
+		return nil, errors.New("must pass at least one type ID")
+	}
+	var ids []int
+	for _, id := range req.TypeId {
+		ids = append(ids, int(id))
+	}
+	res, err := srv.evedb.GetTypeDogmas(ids...)
+	if err != nil {
+		return nil, err
+	}
+	var dogma []*proto.TypeDogma
+	for _, d := range res {
+		dogma = append(dogma, proto.TypeDogmaToProto(d))
+	}
+	return &proto.GetTypeDogmaResponse{Result: successResult, Dogma: dogma}, nil
+}
+
+func (srv *grpcServer) GetAttributeTypes(ctx context.Context, req *proto.GetAttributeTypesRequest) (resp *proto.GetAttributeTypesResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetAttributeTypesResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	if len(req.AttributeId) == 0 {
+		return nil, errors.New("must pass at least one attribute ID")
+	}
+	var ids []int
+	for _, id := range req.AttributeId {
+		ids = append(ids, int(id))
+	}
+	res, err := srv.evedb.GetAttributeTypes(ids...)
+	if err != nil {
+		return nil, err
+	}
+	var attrs []*proto.DogmaAttribute
+	for _, a := range res {
+		attrs = append(attrs, proto.AttributeTypeToProto(a))
+	}
+	return &proto.GetAttributeTypesResponse{Result: successResult, Attribute: attrs}, nil
+}
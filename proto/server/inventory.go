@@ -20,7 +20,7 @@ func (srv *grpcServer) GetInventory(ctx context.Context, req *proto.GetInventory
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	_, charID, err := srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, charID, err := srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (srv *grpcServer) GetInventory(ctx context.Context, req *proto.GetInventory
 	if err != nil {
 		return nil, err
 	}
-	items, err := srv.model.GetCorporationInventory(corpAuth.Context(), char.CorporationID)
+	items, err := srv.model.GetCorporationInventory(corpAuth.WithContext(ctx), char.CorporationID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,7 @@ func (srv *grpcServer) NewInventoryItem(ctx context.Context, req *proto.NewInven
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	_, charID, err := srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, charID, err := srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (srv *grpcServer) NewInventoryItem(ctx context.Context, req *proto.NewInven
 	if err != nil {
 		return nil, err
 	}
-	item, err := srv.model.NewInventoryItem(corpAuth.Context(), corp.CorporationID, int(req.TypeId), int(req.LocationId))
+	item, err := srv.model.NewInventoryItem(corpAuth.WithContext(ctx), corp.CorporationID, int(req.TypeId), int(req.LocationId))
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +100,7 @@ func (srv *grpcServer) SaveInventoryItem(ctx context.Context, req *proto.SaveInv
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	_, charID, err := srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, charID, err := srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func (srv *grpcServer) SaveInventoryItem(ctx context.Context, req *proto.SaveInv
 	}
 	it := proto.ProtoToInventoryItem(req.Item)
 	it.CorporationID = char.CorporationID
-	if err := srv.model.SaveInventoryItem(corpAuth.Context(), it); err != nil {
+	if err := srv.model.SaveInventoryItem(corpAuth.WithContext(ctx), it); err != nil {
 		return nil, err
 	}
 	return &proto.InventoryItemResponse{
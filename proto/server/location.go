@@ -20,7 +20,7 @@ func (srv *grpcServer) GetLocation(ctx context.Context, req *proto.GetLocationRe
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	ctx, _, err = srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, _, err = srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (srv *grpcServer) QueryLocations(ctx context.Context, req *proto.QueryLocat
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	ctx, _, err = srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, _, err = srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
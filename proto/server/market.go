@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 
+	"github.com/motki/core/model"
 	"github.com/motki/core/proto"
 )
 
@@ -36,3 +37,31 @@ func (srv *grpcServer) GetMarketPrice(ctx context.Context, req *proto.GetMarketP
 	}
 	return &proto.GetMarketPriceResponse{Result: successResult, Prices: res}, nil
 }
+
+func (srv *grpcServer) GetMarketHistory(ctx context.Context, req *proto.GetMarketHistoryRequest) (resp *proto.GetMarketHistoryResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.GetMarketHistoryResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	if req.Token == nil {
+		return nil, errors.New("token cannot be empty")
+	}
+	if req.RegionId == 0 || req.TypeId == 0 {
+		return nil, errors.New("must pass a region ID and type ID")
+	}
+	// Unlike GetMarketPrice, missing history is fetched from ESI and stored,
+	// so only authorized sessions may request it.
+	ctx, _, err = srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
+	if err != nil {
+		return nil, err
+	}
+	trend, err := srv.model.GetMarketTrend(ctx, int(req.RegionId), int(req.TypeId), int(req.Days), int(req.Window))
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetMarketHistoryResponse{Result: successResult, Trend: proto.MarketTrendToProto(trend)}, nil
+}
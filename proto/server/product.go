@@ -27,7 +27,7 @@ func (srv *grpcServer) GetProduct(ctx context.Context, req *proto.GetProductRequ
 	if req.Token == nil {
 		return nil, errors.New("token cannot be empty")
 	}
-	_, charID, err := srv.getAuthorizedContext(req.Token, model.RoleLogistics)
+	ctx, charID, err := srv.getAuthorizedContext(ctx, req.Token, model.RoleLogistics)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +197,7 @@ func (srv *grpcServer) UpdateProductPrices(ctx context.Context, req *proto.Updat
 	}
 	prod := proto.ProtoToProduct(req.Product)
 	setCorpID(prod, a.CorporationID)
-	err = srv.model.UpdateProductMarketPrices(a.Context(), prod, prod.MarketRegionID)
+	err = srv.model.UpdateProductMarketPrices(authorizedContext(ctx, a), prod, prod.MarketRegionID)
 	if err != nil {
 		return nil, err
 	}
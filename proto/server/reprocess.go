@@ -0,0 +1,38 @@
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/model"
+	"github.com/motki/core/proto"
+)
+
+func (srv *grpcServer) Reprocess(ctx context.Context, req *proto.ReprocessRequest) (resp *proto.ReprocessResponse, err error) {
+	defer func() {
+		if err != nil {
+			resp = &proto.ReprocessResponse{
+				Result: errorResult(err),
+			}
+			err = nil
+		}
+	}()
+	if req.Token == nil {
+		return nil, errors.New("token cannot be empty")
+	}
+	if req.Params == nil {
+		return nil, errors.New("params cannot be empty")
+	}
+	if len(req.Item) == 0 {
+		return nil, errors.New("must pass at least one item")
+	}
+	var items []*model.ReprocessItem
+	for _, it := range req.Item {
+		items = append(items, proto.ProtoToReprocessItem(it))
+	}
+	res, err := srv.model.Reprocess(proto.ProtoToReprocessParams(req.Params), items...)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ReprocessResponse{Result: successResult, Reprocessed: proto.ReprocessResultToProto(res)}, nil
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/motki/core/log"
+	"github.com/motki/core/model"
+	"github.com/motki/core/proto"
+)
+
+// requestIDHeader is the metadata key containing the ID of a request.
+//
+// Clients may provide their own request ID, otherwise one is generated. The
+// ID is sent back to the client in the response header.
+const requestIDHeader = "x-request-id"
+
+// newRequestID returns a random request ID.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// requestID returns the request ID sent by the client, or a new one.
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDHeader); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+	return newRequestID()
+}
+
+// resultResponse is implemented by every response containing a Result.
+type resultResponse interface {
+	GetResult() *proto.Result
+}
+
+// intercept assigns each call a request ID and stores a Logger enriched with
+// the request ID and method in the call's context.
+//
+// Use log.FromContext to log within the scope of a request.
+func (srv *grpcServer) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestID(ctx)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id)); err != nil {
+		srv.logger.Debugf("grpc server: unable to set request id header: %s", err.Error())
+	}
+	l := srv.logger.WithFields(logrus.Fields{
+		log.FieldRequestID: id,
+		log.FieldMethod:    info.FullMethod,
+	})
+	start := time.Now()
+	resp, err := handler(log.NewContext(ctx, l), req)
+	l = l.WithField("duration", time.Now().Sub(start))
+	if err != nil {
+		l.Warnf("grpc server: request failed: %s", err.Error())
+	} else if r, ok := resp.(resultResponse); ok && r.GetResult().GetStatus() == proto.Status_FAILURE {
+		l.Warnf("grpc server: request failed: %s", r.GetResult().GetDescription())
+	} else {
+		l.Debugf("grpc server: request complete")
+	}
+	return resp, err
+}
+
+// authorizedContext returns a copy of ctx that carries the given authorization,
+// with its details added to the request's Logger.
+func authorizedContext(ctx context.Context, a *model.Authorization) context.Context {
+	return a.WithContext(log.WithFields(ctx, logrus.Fields{
+		log.FieldUserID:        a.UserID,
+		log.FieldCharacterID:   a.CharacterID,
+		log.FieldCorporationID: a.CorporationID,
+	}))
+}
@@ -57,7 +57,8 @@ type grpcServer struct {
 
 // New creates a new Server using the given configuration and dependencies.
 func New(conf proto.Config, m *model.Manager, edb *evedb.EveDB, api *eveapi.EveAPI, l log.Logger) (Server, error) {
-	srv := &grpcServer{config: conf, model: m, evedb: edb, eveapi: api, logger: l, grpc: grpc.NewServer()}
+	srv := &grpcServer{config: conf, model: m, evedb: edb, eveapi: api, logger: l}
+	srv.grpc = grpc.NewServer(grpc.UnaryInterceptor(srv.intercept))
 	proto.RegisterAuthenticationServiceServer(srv.grpc, srv)
 	proto.RegisterProductServiceServer(srv.grpc, srv)
 	proto.RegisterMarketPriceServiceServer(srv.grpc, srv)
@@ -66,6 +67,7 @@ func New(conf proto.Config, m *model.Manager, edb *evedb.EveDB, api *eveapi.EveA
 	proto.RegisterCorporationServiceServer(srv.grpc, srv)
 	proto.RegisterInventoryServiceServer(srv.grpc, srv)
 	proto.RegisterLocationServiceServer(srv.grpc, srv)
+	proto.RegisterReprocessingServiceServer(srv.grpc, srv)
 	return srv, nil
 }
 
@@ -141,7 +143,9 @@ func (srv *grpcServer) Authenticate(ctx context.Context, req *proto.Authenticate
 	return &proto.AuthenticateResponse{Result: successResult, Token: &proto.Token{Identifier: tok}}, nil
 }
 
-func (srv *grpcServer) getAuthorizedContext(tok *proto.Token, role model.Role) (context.Context, int, error) {
+// getAuthorizedContext returns a copy of ctx that carries the authorization
+// for the given token and role, along with the authorized character's ID.
+func (srv *grpcServer) getAuthorizedContext(ctx context.Context, tok *proto.Token, role model.Role) (context.Context, int, error) {
 	if tok == nil || tok.Identifier == "" {
 		return nil, 0, errors.New("token cannot be empty")
 	}
@@ -156,5 +160,5 @@ func (srv *grpcServer) getAuthorizedContext(tok *proto.Token, role model.Role) (
 	if err = srv.model.SaveAuthorization(user, role, int(a.CharacterID), a.Token); err != nil {
 		return nil, 0, err
 	}
-	return a.Context(), int(a.CharacterID), nil
+	return authorizedContext(ctx, a), int(a.CharacterID), nil
 }
@@ -0,0 +1,19 @@
+DROP TABLE IF EXISTS app.jobs;
+CREATE TABLE app.jobs
+(
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  kind VARCHAR(255) NOT NULL,
+  payload BYTEA NOT NULL DEFAULT '',
+  run_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  attempts INT NOT NULL DEFAULT 0,
+  leased_by VARCHAR(255) NULL,
+  leased_until TIMESTAMP NULL,
+  last_error TEXT NULL,
+  failed_at TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+DROP INDEX IF EXISTS idx_jobs_run_at;
+CREATE INDEX idx_jobs_run_at
+  ON app.jobs (run_at)
+  WHERE failed_at IS NULL;
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS app.http_cache;
+CREATE TABLE app.http_cache
+(
+  key TEXT PRIMARY KEY NOT NULL,
+  response BYTEA NOT NULL,
+  updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+DROP INDEX IF EXISTS idx_http_cache_updated_at;
+CREATE INDEX idx_http_cache_updated_at
+  ON app.http_cache (updated_at);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS app.market_history;
+CREATE TABLE app.market_history
+(
+  region_id INT NOT NULL,
+  type_id INT NOT NULL,
+  date DATE NOT NULL,
+  volume BIGINT NOT NULL,
+  average NUMERIC NOT NULL,
+  highest NUMERIC NOT NULL,
+  lowest NUMERIC NOT NULL,
+  fetched_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (region_id, type_id, date)
+);
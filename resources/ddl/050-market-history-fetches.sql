@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS app.market_history_fetches;
+CREATE TABLE app.market_history_fetches
+(
+  region_id INT NOT NULL,
+  type_id INT NOT NULL,
+  fetched_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (region_id, type_id)
+);
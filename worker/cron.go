@@ -0,0 +1,319 @@
+package worker
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A CronSchedule describes a recurring set of times using a cron expression.
+//
+// Standard 5-field expressions are supported, in the form:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Each field may be "*", a single value, a range "a-b", a list "a,b,c", or
+// any of those followed by a step "/n". Months and weekdays may also be given
+// by their three-letter English names. As in most cron implementations, if
+// both day-of-month and day-of-week are restricted, a time matches when
+// either field matches.
+//
+// The following descriptors are also supported:
+//
+//	@yearly, @annually  Once a year at midnight on January 1st.
+//	@monthly            Once a month at midnight on the 1st.
+//	@weekly             Once a week at midnight on Sunday.
+//	@daily, @midnight   Once a day at midnight.
+//	@hourly             Once an hour at the start of the hour.
+//
+// Unless otherwise specified, schedules are evaluated in UTC.
+type CronSchedule struct {
+	spec string
+
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// Whether day-of-month or day-of-week were given as "*".
+	domStar bool
+	dowStar bool
+
+	loc *time.Location
+}
+
+// cronDescriptors maps each supported descriptor to its equivalent expression.
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// A cronField describes the bounds and value names for one field.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{"minute", 0, 59, nil}
+	cronHour   = cronField{"hour", 0, 23, nil}
+	cronDom    = cronField{"day-of-month", 1, 31, nil}
+	cronMonth  = cronField{"month", 1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDow = cronField{"day-of-week", 0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// ParseCron parses the given cron expression, evaluating it in UTC.
+func ParseCron(spec string) (*CronSchedule, error) {
+	return ParseCronIn(spec, time.UTC)
+}
+
+// ParseCronIn parses the given cron expression, evaluating it in the given location.
+func ParseCronIn(spec string, loc *time.Location) (*CronSchedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	expr := strings.TrimSpace(spec)
+	if strings.HasPrefix(expr, "@") {
+		e, ok := cronDescriptors[strings.ToLower(expr)]
+		if !ok {
+			return nil, errors.Errorf("cron: unknown descriptor %s", expr)
+		}
+		expr = e
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("cron: expected 5 fields, got %d in %q", len(fields), spec)
+	}
+	c := &CronSchedule{spec: spec, loc: loc}
+	var err error
+	if c.minute, err = cronMinute.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hour, err = cronHour.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.dom, err = cronDom.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.month, err = cronMonth.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.dow, err = cronDow.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	// Sunday may be given as 0 or 7.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domStar = fields[2] == "*" || fields[2] == "?"
+	c.dowStar = fields[4] == "*" || fields[4] == "?"
+	return c, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression is invalid.
+func MustParseCron(spec string) *CronSchedule {
+	c, err := ParseCron(spec)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// parse returns a bitset containing each value matched by the given field expression.
+func (f cronField) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+// parsePart parses a single element of a comma-separated field expression.
+func (f cronField) parsePart(part string) (uint64, error) {
+	rng, step := part, 1
+	if i := strings.Index(part, "/"); i >= 0 {
+		s, err := strconv.Atoi(part[i+1:])
+		if err != nil || s < 1 {
+			return 0, errors.Errorf("cron: invalid step in %s field: %s", f.name, part)
+		}
+		rng, step = part[:i], s
+	}
+	lo, hi := f.min, f.max
+	switch {
+	case rng == "*" || rng == "?":
+		// Use the full range.
+	case strings.Contains(rng, "-"):
+		i := strings.Index(rng, "-")
+		var err error
+		if lo, err = f.value(rng[:i]); err != nil {
+			return 0, err
+		}
+		if hi, err = f.value(rng[i+1:]); err != nil {
+			return 0, err
+		}
+	default:
+		v, err := f.value(rng)
+		if err != nil {
+			return 0, err
+		}
+		lo = v
+		if step == 1 {
+			// A single value, not "a/n" which means "a through max".
+			hi = v
+		}
+	}
+	if lo > hi {
+		return 0, errors.Errorf("cron: invalid range in %s field: %s", f.name, part)
+	}
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+// value parses a single numeric or named value, ensuring it is within bounds.
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("cron: invalid value in %s field: %s", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, errors.Errorf("cron: %s value %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (c *CronSchedule) String() string {
+	return c.spec
+}
+
+// matchDay returns true if the given day matches the schedule's day-of-month
+// and day-of-week fields.
+func (c *CronSchedule) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domStar && c.dowStar:
+		return true
+	case c.domStar:
+		return dow
+	case c.dowStar:
+		return dom
+	}
+	return dom || dow
+}
+
+// Next returns the first time matched by the schedule strictly after t.
+//
+// The zero time is returned if no matching time exists within five years.
+func (c *CronSchedule) Next(t time.Time) time.Time {
+	orig := t.Location()
+	t = t.In(c.loc).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.loc)
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		return t.In(orig)
+	}
+	return time.Time{}
+}
+
+// NextN returns the next n times matched by the schedule after t.
+func (c *CronSchedule) NextN(t time.Time, n int) []time.Time {
+	var res []time.Time
+	for i := 0; i < n; i++ {
+		t = c.Next(t)
+		if t.IsZero() {
+			break
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
+// A CronJob is a job that is repeated according to a CronSchedule.
+type CronJob struct {
+	Job
+
+	Schedule *CronSchedule
+}
+
+// NextRuns returns the next n times the job is scheduled to run.
+func (j *CronJob) NextRuns(n int) []time.Time {
+	return j.Schedule.NextN(time.Now(), n)
+}
+
+// ScheduleCron schedules a job to be performed at each time matched by the
+// given cron expression.
+//
+// As with RepeatEvery, a job that returns an error is not rescheduled unless
+// the ContinueOnError or RetryWith options are given.
+func (s *Scheduler) ScheduleCron(spec string, j Job, opts ...RepeatOption) (*CronJob, error) {
+	c, err := ParseCron(spec)
+	if err != nil {
+		return nil, err
+	}
+	return s.ScheduleCronSchedule(c, j, opts...)
+}
+
+// ScheduleFuncCron is a convenience method for scheduling a bare func with a
+// cron expression.
+func (s *Scheduler) ScheduleFuncCron(spec string, j func() error, opts ...RepeatOption) (*CronJob, error) {
+	return s.ScheduleCron(spec, JobFunc(j), opts...)
+}
+
+// ScheduleCronSchedule schedules a job to be performed at each time matched by
+// the given schedule.
+func (s *Scheduler) ScheduleCronSchedule(c *CronSchedule, j Job, opts ...RepeatOption) (*CronJob, error) {
+	next := c.Next(time.Now())
+	if next.IsZero() {
+		return nil, errors.Errorf("cron: schedule %s never fires", c)
+	}
+	cj := &CronJob{
+		Job: s.repeat(j, func() time.Time {
+			return c.Next(time.Now())
+		}, opts...),
+		Schedule: c,
+	}
+	if err := s.scheduleAfter(cj.Job, next.Sub(time.Now())); err != nil {
+		return nil, err
+	}
+	return cj, nil
+}
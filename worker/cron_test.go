@@ -0,0 +1,112 @@
+package worker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/motki/core/log"
+	"github.com/motki/core/worker"
+)
+
+// TestParseCron tests computing the next fire times for various expressions.
+func TestParseCron(t *testing.T) {
+	// Thursday, March 1st 2018.
+	start := time.Date(2018, time.March, 1, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		spec     string
+		expected []time.Time
+	}{
+		{"5 11 * * *", []time.Time{
+			time.Date(2018, time.March, 1, 11, 5, 0, 0, time.UTC),
+			time.Date(2018, time.March, 2, 11, 5, 0, 0, time.UTC),
+		}},
+		{"0 18 * * mon-fri", []time.Time{
+			time.Date(2018, time.March, 1, 18, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 2, 18, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 5, 18, 0, 0, 0, time.UTC),
+		}},
+		{"*/20 * * * *", []time.Time{
+			time.Date(2018, time.March, 1, 10, 40, 0, 0, time.UTC),
+			time.Date(2018, time.March, 1, 11, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 1, 11, 20, 0, 0, time.UTC),
+		}},
+		{"@hourly", []time.Time{
+			time.Date(2018, time.March, 1, 11, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC),
+		}},
+		{"@monthly", []time.Time{
+			time.Date(2018, time.April, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 13 * 5", []time.Time{
+			time.Date(2018, time.March, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 13, 0, 0, 0, 0, time.UTC),
+		}},
+		{"30 12 29 feb *", []time.Time{
+			time.Date(2020, time.February, 29, 12, 30, 0, 0, time.UTC),
+		}},
+	}
+	for _, test := range tests {
+		c, err := worker.ParseCron(test.spec)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.spec, err.Error())
+			continue
+		}
+		actual := c.NextN(start, len(test.expected))
+		if len(actual) != len(test.expected) {
+			t.Errorf("%s: expected %d times, got %d", test.spec, len(test.expected), len(actual))
+			continue
+		}
+		for i, e := range test.expected {
+			if !actual[i].Equal(e) {
+				t.Errorf("%s: expected %s, got %s", test.spec, e, actual[i])
+			}
+		}
+	}
+}
+
+// TestParseCronInvalid tests that invalid expressions are rejected.
+func TestParseCronInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"@fortnightly",
+	} {
+		if _, err := worker.ParseCron(spec); err == nil {
+			t.Errorf("%q: expected error, got none", spec)
+		}
+	}
+}
+
+// TestScheduleCron tests scheduling a job with a cron expression.
+func TestScheduleCron(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	j, err := sched.ScheduleFuncCron("@daily", func() error { return nil })
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	runs := j.NextRuns(3)
+	if len(runs) != 3 {
+		t.Errorf("expected 3 next runs, got %d", len(runs))
+		return
+	}
+	for i, r := range runs {
+		if r.Hour() != 0 || r.Minute() != 0 {
+			t.Errorf("expected run at midnight, got %s", r)
+		}
+		if i > 0 && r.Sub(runs[i-1]) != 24*time.Hour {
+			t.Errorf("expected runs to be one day apart, got %s", r.Sub(runs[i-1]))
+		}
+	}
+}
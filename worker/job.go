@@ -0,0 +1,394 @@
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// ErrJobNotFound is returned when no job exists with the given name.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrDuplicateJob is returned when a named job is scheduled while another job
+// with the same name is pending.
+var ErrDuplicateJob = errors.New("a job with the same name is already pending")
+
+// A NamedJob is a Job with a name that uniquely identifies it within a Scheduler.
+type NamedJob interface {
+	Job
+
+	// Name returns the name of the job.
+	Name() string
+}
+
+type namedJob struct {
+	Job
+
+	name string
+}
+
+func (j namedJob) Name() string {
+	return j.name
+}
+
+// Named gives a job a name, allowing it to be looked up, paused, resumed or
+// canceled.
+//
+// Only one job with a given name may be pending at a time. Names are kept
+// when a named job is wrapped by Retry, RepeatEvery, or ScheduleCron.
+func Named(name string, j Job) NamedJob {
+	return namedJob{Job: j, name: name}
+}
+
+// withName returns res with the same name as j, if j is named.
+func withName(j Job, res Job) Job {
+	if n, ok := j.(NamedJob); ok {
+		return Named(n.Name(), res)
+	}
+	return res
+}
+
+// JobStatus describes the state of a job.
+type JobStatus int
+
+const (
+	JobScheduled JobStatus = iota // Waiting to be performed.
+	JobRunning                    // Currently being performed.
+	JobPaused                     // Paused; will not be performed until resumed.
+	JobSucceeded                  // Finished without error.
+	JobFailed                     // Finished with an error.
+	JobCanceled                   // Canceled; will not be performed again.
+)
+
+func (s JobStatus) String() string {
+	switch s {
+	case JobScheduled:
+		return "scheduled"
+	case JobRunning:
+		return "running"
+	case JobPaused:
+		return "paused"
+	case JobSucceeded:
+		return "succeeded"
+	case JobFailed:
+		return "failed"
+	case JobCanceled:
+		return "canceled"
+	}
+	return fmt.Sprintf("JobStatus(%d)", int(s))
+}
+
+// JobInfo is a snapshot of the state of a job.
+type JobInfo struct {
+	ID     int64
+	Name   string // Empty if the job is unnamed.
+	Status JobStatus
+
+	Runs         int           // Number of times the job has been performed.
+	LastRun      time.Time     // When the job was last started.
+	LastDuration time.Duration // How long the last run took.
+	LastError    error         // Error returned by the last run, if any.
+
+	NextRun time.Time // When the job is next scheduled; zero if not scheduled.
+}
+
+// entry tracks a single job from the time it is scheduled until it finishes.
+//
+// A repeating or retried job keeps the same entry for each run.
+type entry struct {
+	id   int64
+	name string
+
+	mu sync.Mutex
+
+	job     Job
+	queued  int  // Number of times the entry is in the schedule or waiting queue.
+	active  int  // Number of runs in progress.
+	held    bool // Whether the entry came due while paused.
+	paused  bool
+	stopped bool // Whether the entry has been canceled.
+
+	stop context.CancelFunc // Cancels the current run, if any.
+
+	runs     int
+	lastRun  time.Time
+	lastDur  time.Duration
+	lastErr  error
+	nextRun  time.Time
+	finished bool
+}
+
+// String returns the name of the entry, or its ID if it is unnamed.
+func (e *entry) String() string {
+	if e.name != "" {
+		return e.name
+	}
+	return fmt.Sprintf("#%d", e.id)
+}
+
+// queue records that the entry was added to be performed at t.
+func (e *entry) queue(t time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.queued++
+	e.nextRun = t
+	e.finished = false
+}
+
+// replace sets the job to be performed on the entry's next run. It returns
+// false if the entry has been canceled.
+func (e *entry) replace(j Job) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stopped {
+		return false
+	}
+	e.job = j
+	return true
+}
+
+// start begins a run of the entry, returning the job to perform and the
+// context to perform it with. The returned CancelFunc must be called once the
+// run is complete. It returns false if the entry is paused or canceled.
+func (e *entry) start(parent context.Context) (Job, context.Context, context.CancelFunc, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.queued--
+	if e.stopped {
+		return nil, nil, nil, false
+	}
+	if e.paused {
+		e.held = true
+		return nil, nil, nil, false
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(parent, entryKey{}, e))
+	e.stop = cancel
+	e.active++
+	e.runs++
+	e.lastRun = time.Now()
+	if e.queued == 0 {
+		e.nextRun = time.Time{}
+	}
+	return e.job, ctx, cancel, true
+}
+
+// finish records the outcome of a run begun at start. It returns true if the
+// entry has nothing left to do.
+func (e *entry) finish(start time.Time, err error) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.active--
+	e.lastDur = time.Now().Sub(start)
+	e.lastErr = err
+	if e.active > 0 {
+		return false
+	}
+	e.stop = nil
+	if e.queued > 0 || e.held {
+		return false
+	}
+	e.finished = true
+	return true
+}
+
+// info returns a snapshot of the entry's state.
+func (e *entry) info() JobInfo {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	i := JobInfo{
+		ID:           e.id,
+		Name:         e.name,
+		Runs:         e.runs,
+		LastRun:      e.lastRun,
+		LastDuration: e.lastDur,
+		LastError:    e.lastErr,
+		NextRun:      e.nextRun,
+	}
+	switch {
+	case e.stopped:
+		i.Status = JobCanceled
+	case e.active > 0:
+		i.Status = JobRunning
+	case e.paused:
+		i.Status = JobPaused
+	case e.finished && e.lastErr != nil:
+		i.Status = JobFailed
+	case e.finished:
+		i.Status = JobSucceeded
+	default:
+		i.Status = JobScheduled
+	}
+	return i
+}
+
+// pending returns true if the entry may still be performed.
+func (e *entry) pending() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !e.stopped && !e.finished
+}
+
+type entryKey struct{}
+
+// entryFromContext returns the entry being performed with ctx, if any.
+func entryFromContext(ctx context.Context) *entry {
+	if ctx == nil {
+		return nil
+	}
+	e, _ := ctx.Value(entryKey{}).(*entry)
+	return e
+}
+
+// track begins tracking the given job.
+//
+// ErrDuplicateJob is returned if the job is named and another job with the
+// same name is pending.
+func (s *Scheduler) track(j Job) (*entry, error) {
+	e := &entry{job: j}
+	if n, ok := j.(NamedJob); ok {
+		e.name = n.Name()
+	}
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	if e.name != "" {
+		if o, ok := s.names[e.name]; ok {
+			if o.pending() {
+				return nil, errors.Wrap(ErrDuplicateJob, e.name)
+			}
+			delete(s.jobs, o.id)
+		}
+		s.names[e.name] = e
+	}
+	s.nextID++
+	e.id = s.nextID
+	s.jobs[e.id] = e
+	return e, nil
+}
+
+// untrack stops tracking an entry that could not be scheduled.
+func (s *Scheduler) untrack(e *entry) {
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	delete(s.jobs, e.id)
+	if s.names[e.name] == e {
+		delete(s.names, e.name)
+	}
+}
+
+// retire stops tracking the entry once it has finished.
+//
+// Named jobs remain visible until another job with the same name is scheduled.
+func (s *Scheduler) retire(e *entry) {
+	if e.name != "" {
+		return
+	}
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	delete(s.jobs, e.id)
+}
+
+// lookup returns the named entry.
+func (s *Scheduler) lookup(name string) (*entry, error) {
+	s.jobsMu.Lock()
+	defer s.jobsMu.Unlock()
+	e, ok := s.names[name]
+	if !ok {
+		return nil, errors.Wrap(ErrJobNotFound, name)
+	}
+	return e, nil
+}
+
+// Jobs returns a snapshot of every job known to the scheduler, ordered by ID.
+//
+// Unnamed jobs are listed until they finish. Named jobs are listed until
+// another job with the same name is scheduled.
+func (s *Scheduler) Jobs() []JobInfo {
+	s.jobsMu.Lock()
+	entries := make([]*entry, 0, len(s.jobs))
+	for _, e := range s.jobs {
+		entries = append(entries, e)
+	}
+	s.jobsMu.Unlock()
+	res := make([]JobInfo, len(entries))
+	for i, e := range entries {
+		res[i] = e.info()
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// Lookup returns a snapshot of the named job.
+func (s *Scheduler) Lookup(name string) (JobInfo, bool) {
+	e, err := s.lookup(name)
+	if err != nil {
+		return JobInfo{}, false
+	}
+	return e.info(), true
+}
+
+// Cancel cancels the named job.
+//
+// A job that is waiting to be performed is discarded, and the context of a
+// job that is currently running is canceled. Canceled jobs are not retried
+// or repeated.
+func (s *Scheduler) Cancel(name string) error {
+	e, err := s.lookup(name)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.stopped = true
+	e.held = false
+	e.nextRun = time.Time{}
+	if e.stop != nil {
+		e.stop()
+		e.stop = nil
+	}
+	return nil
+}
+
+// Pause prevents the named job from being performed until it is resumed.
+//
+// A run already in progress is not interrupted.
+func (s *Scheduler) Pause(name string) error {
+	e, err := s.lookup(name)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stopped || e.finished {
+		return errors.Errorf("job %s is no longer pending", name)
+	}
+	e.paused = true
+	return nil
+}
+
+// Resume allows a paused job to be performed again. If the job came due
+// while it was paused, it is performed at the next tick.
+func (s *Scheduler) Resume(name string) error {
+	e, err := s.lookup(name)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	if !e.paused {
+		e.mu.Unlock()
+		return errors.Errorf("job %s is not paused", name)
+	}
+	e.paused = false
+	held := e.held
+	e.held = false
+	e.mu.Unlock()
+	if held {
+		return s.scheduleEntry(e, time.Now(), true)
+	}
+	return nil
+}
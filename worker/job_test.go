@@ -0,0 +1,203 @@
+package worker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/log"
+	"github.com/motki/core/worker"
+)
+
+// TestNamedJobDuplicate tests that a named job cannot be scheduled twice.
+func TestNamedJobDuplicate(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	j := worker.Named("refresh", worker.JobFunc(func() error {
+		return nil
+	}))
+	if err := sched.ScheduleAt(j, time.Now().Add(time.Hour)); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if err := sched.Schedule(j); errors.Cause(err) != worker.ErrDuplicateJob {
+		t.Errorf("expected ErrDuplicateJob, got %v", err)
+	}
+
+	info, ok := sched.Lookup("refresh")
+	if !ok {
+		t.Error("expected to find job")
+		return
+	}
+	if info.Status != worker.JobScheduled {
+		t.Errorf("expected status scheduled, got %s", info.Status)
+	}
+	if info.NextRun.IsZero() {
+		t.Error("expected next run to be set")
+	}
+
+	if err := sched.Cancel("refresh"); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if err := sched.ScheduleAt(j, time.Now().Add(time.Hour)); err != nil {
+		t.Errorf("expected to reschedule canceled job, got %s", err.Error())
+	}
+	if n := len(sched.Jobs()); n != 1 {
+		t.Errorf("expected 1 job, got %d", n)
+	}
+}
+
+// TestCancelRunningJob tests that canceling a job cancels its context.
+func TestCancelRunningJob(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	started := make(chan struct{})
+	done := make(chan error)
+	err := sched.Schedule(worker.Named("long", worker.ContextJobFunc(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		done <- ctx.Err()
+		return ctx.Err()
+	})))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case <-started:
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not start job in time")
+		return
+	}
+	if info, _ := sched.Lookup("long"); info.Status != worker.JobRunning {
+		t.Errorf("expected status running, got %s", info.Status)
+	}
+	if err := sched.Cancel("long"); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(250 * time.Millisecond):
+		t.Error("job was not interrupted in time")
+	}
+	if info, _ := sched.Lookup("long"); info.Status != worker.JobCanceled {
+		t.Errorf("expected status canceled, got %s", info.Status)
+	}
+}
+
+// TestShutdownCancelsJobs tests that shutting down cancels running jobs' contexts.
+func TestShutdownCancelsJobs(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+
+	started := make(chan struct{})
+	err := sched.Schedule(worker.ContextJobFunc(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return nil
+	}))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	<-started
+	if err := sched.Shutdown(); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
+
+// TestPauseResume tests that paused jobs are not performed until resumed.
+func TestPauseResume(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	q := make(chan struct{}, 10)
+	err := sched.Schedule(worker.Named("tick", sched.RepeatFuncEvery(func() error {
+		q <- struct{}{}
+		return nil
+	}, delay)))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case <-q:
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not process job in time")
+		return
+	}
+	if err := sched.Pause("tick"); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	// Allow any run already in progress to finish.
+	time.Sleep(10 * time.Millisecond)
+	for len(q) > 0 {
+		<-q
+	}
+	time.Sleep(10 * time.Millisecond)
+	if len(q) > 0 {
+		t.Error("expected paused job not to be performed")
+		return
+	}
+	info, _ := sched.Lookup("tick")
+	if info.Status != worker.JobPaused {
+		t.Errorf("expected status paused, got %s", info.Status)
+	}
+	if info.Runs < 1 {
+		t.Errorf("expected at least 1 run, got %d", info.Runs)
+	}
+
+	if err := sched.Resume("tick"); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	select {
+	case <-q:
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not resume job in time")
+	}
+}
+
+// TestJobInfoLastError tests that the outcome of a named job is recorded.
+func TestJobInfoLastError(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	done := make(chan struct{})
+	err := sched.Schedule(worker.Named("broken", worker.JobFunc(func() error {
+		defer close(done)
+		return errors.New("error with running the job")
+	})))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	<-done
+
+	for i := 0; ; i++ {
+		info, _ := sched.Lookup("broken")
+		if info.Status == worker.JobFailed {
+			if info.LastError == nil || info.Runs != 1 {
+				t.Errorf("expected 1 run with an error, got %d runs and %v", info.Runs, info.LastError)
+			}
+			return
+		}
+		if i >= 10 {
+			t.Errorf("expected status failed, got %s", info.Status)
+			return
+		}
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,190 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx"
+
+	"github.com/motki/core/db"
+)
+
+// PostgresStore is a Store backed by the app.jobs table.
+//
+// Jobs are leased using SELECT ... FOR UPDATE SKIP LOCKED, so any number of
+// processes may safely share the same table.
+//
+// A store is opt-in; app.NewEnv does not configure one. Applications that
+// schedule DurableJobs register their kinds and then call:
+//
+//	env.Scheduler.UseStore(worker.NewPostgresStore(env.DB))
+type PostgresStore struct {
+	pool *db.ConnPool
+
+	// Identifies this store in the leased_by column.
+	name string
+}
+
+// storeSeq distinguishes stores created by the same process.
+var storeSeq int64
+
+// NewPostgresStore creates a new PostgresStore using the given connection pool.
+func NewPostgresStore(pool *db.ConnPool) *PostgresStore {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	name := fmt.Sprintf("%s:%d:%d", host, os.Getpid(), atomic.AddInt64(&storeSeq, 1))
+	return &PostgresStore{pool: pool, name: name}
+}
+
+// millis returns the duration as fractional milliseconds.
+//
+// Times are passed to the database as offsets from NOW() to avoid any clock
+// or time zone differences between processes and the database server.
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Enqueue inserts a job to be performed at or after runAt, returning its ID.
+func (s *PostgresStore) Enqueue(kind string, payload []byte, runAt time.Time) (int64, error) {
+	c, err := s.pool.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer s.pool.Release(c)
+	if payload == nil {
+		payload = []byte{}
+	}
+	var id int64
+	err = c.QueryRow(
+		`INSERT INTO app.jobs (id, kind, payload, run_at)
+			VALUES(DEFAULT, $1, $2, NOW() + ($3::DOUBLE PRECISION * INTERVAL '1 millisecond'))
+			RETURNING id`,
+		kind,
+		payload,
+		millis(time.Until(runAt)),
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Lease reserves up to n ready jobs for this process until the visibility
+// timeout elapses, incrementing their attempt counts. Jobs are leased in order
+// of their run time.
+func (s *PostgresStore) Lease(n int, visibility time.Duration) ([]*StoredJob, error) {
+	c, err := s.pool.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer s.pool.Release(c)
+	rs, err := c.Query(
+		`UPDATE app.jobs j
+			SET attempts = j.attempts + 1
+			  , leased_by = $3
+			  , leased_until = NOW() + ($2::DOUBLE PRECISION * INTERVAL '1 millisecond')
+			WHERE j.id IN (
+			  SELECT q.id
+			    FROM app.jobs q
+			    WHERE q.failed_at IS NULL
+			      AND q.run_at <= NOW()
+			      AND (q.leased_until IS NULL OR q.leased_until < NOW())
+			    ORDER BY q.run_at
+			    LIMIT $1
+			    FOR UPDATE SKIP LOCKED)
+			RETURNING j.id, j.kind, j.payload, j.attempts, j.run_at`,
+		n,
+		millis(visibility),
+		s.name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+	var res []*StoredJob
+	for rs.Next() {
+		j := &StoredJob{}
+		var attempts int32
+		if err := rs.Scan(&j.ID, &j.Kind, &j.Payload, &attempts, &j.RunAt); err != nil {
+			return nil, err
+		}
+		j.Attempts = int(attempts)
+		res = append(res, j)
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// leaseResult returns ErrLeaseLost if an update to a leased job affected no
+// rows.
+func leaseResult(tag pgx.CommandTag, err error) error {
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+// Complete deletes the job, which must be leased by this store.
+func (s *PostgresStore) Complete(id int64) error {
+	c, err := s.pool.Open()
+	if err != nil {
+		return err
+	}
+	defer s.pool.Release(c)
+	return leaseResult(c.Exec(`DELETE FROM app.jobs WHERE id = $1 AND leased_by = $2`, id, s.name))
+}
+
+// Retry releases the job's lease and records the error, making the job
+// available again at runAt. The job must be leased by this store.
+func (s *PostgresStore) Retry(id int64, jerr error, runAt time.Time) error {
+	c, err := s.pool.Open()
+	if err != nil {
+		return err
+	}
+	defer s.pool.Release(c)
+	return leaseResult(c.Exec(
+		`UPDATE app.jobs
+			SET leased_by = NULL
+			  , leased_until = NULL
+			  , last_error = $2
+			  , run_at = NOW() + ($3::DOUBLE PRECISION * INTERVAL '1 millisecond')
+			WHERE id = $1
+			  AND leased_by = $4`,
+		id,
+		jerr.Error(),
+		millis(time.Until(runAt)),
+		s.name,
+	))
+}
+
+// Fail releases the job's lease and records the error, marking the job failed
+// so that it is never leased again. Failed jobs are kept for inspection. The
+// job must be leased by this store.
+func (s *PostgresStore) Fail(id int64, jerr error) error {
+	c, err := s.pool.Open()
+	if err != nil {
+		return err
+	}
+	defer s.pool.Release(c)
+	return leaseResult(c.Exec(
+		`UPDATE app.jobs
+			SET leased_by = NULL
+			  , leased_until = NULL
+			  , last_error = $2
+			  , failed_at = NOW()
+			WHERE id = $1
+			  AND leased_by = $3`,
+		id,
+		jerr.Error(),
+		s.name,
+	))
+}
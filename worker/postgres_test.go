@@ -0,0 +1,140 @@
+package worker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/motki/core/db"
+	"github.com/motki/core/db/dbtest"
+	"github.com/motki/core/worker"
+)
+
+func expectLeased(t *testing.T, s *worker.PostgresStore, visibility time.Duration, ids ...int64) []*worker.StoredJob {
+	jobs, err := s.Lease(10, visibility)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(jobs) != len(ids) {
+		t.Fatalf("expected %d jobs to be leased, got %d", len(ids), len(jobs))
+	}
+	for i, id := range ids {
+		if jobs[i].ID != id {
+			t.Errorf("expected job %d to be leased, got %d", id, jobs[i].ID)
+		}
+	}
+	return jobs
+}
+
+func jobState(t *testing.T, p *db.ConnPool, id int64) (lastError string, failed bool) {
+	c, err := p.Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer p.Release(c)
+	var e *string
+	err = c.QueryRow(`SELECT last_error, failed_at IS NOT NULL FROM app.jobs WHERE id = $1`, id).Scan(&e, &failed)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if e != nil {
+		lastError = *e
+	}
+	return lastError, failed
+}
+
+// TestPostgresStore tests that jobs are leased when ready and not already
+// leased, and that completed, retried and failed jobs are recorded.
+func TestPostgresStore(t *testing.T) {
+	p := dbtest.New(t)
+	defer p.Close()
+	dbtest.Truncate(t, p, "app.jobs")
+	s := worker.NewPostgresStore(p)
+
+	ready, err := s.Enqueue("refresh", []byte("payload"), time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if _, err = s.Enqueue("refresh", nil, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	jobs := expectLeased(t, s, time.Minute, ready)
+	if j := jobs[0]; j.Kind != "refresh" || string(j.Payload) != "payload" || j.Attempts != 1 {
+		t.Errorf("unexpected job: %+v", j)
+	}
+	expectLeased(t, s, time.Minute)
+
+	if err = s.Retry(ready, errors.New("try again"), time.Now()); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if e, _ := jobState(t, p, ready); e != "try again" {
+		t.Errorf("expected last error to be recorded, got %q", e)
+	}
+	jobs = expectLeased(t, s, time.Millisecond, ready)
+	if jobs[0].Attempts != 2 {
+		t.Errorf("expected attempt 2, got %d", jobs[0].Attempts)
+	}
+
+	// The lease expires without the job being completed.
+	time.Sleep(50 * time.Millisecond)
+	expectLeased(t, s, time.Minute, ready)
+
+	if err = s.Fail(ready, errors.New("broken")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if e, failed := jobState(t, p, ready); e != "broken" || !failed {
+		t.Errorf("expected job to be failed with last error, got %q, %v", e, failed)
+	}
+	expectLeased(t, s, time.Minute)
+
+	done, err := s.Enqueue("refresh", nil, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expectLeased(t, s, time.Millisecond, done)
+	if err = s.Complete(done); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	time.Sleep(50 * time.Millisecond)
+	expectLeased(t, s, time.Minute)
+}
+
+// TestPostgresStoreLeaseLost tests that a job can only be completed, retried
+// or failed by the store holding its lease.
+func TestPostgresStoreLeaseLost(t *testing.T) {
+	p := dbtest.New(t)
+	defer p.Close()
+	dbtest.Truncate(t, p, "app.jobs")
+	a := worker.NewPostgresStore(p)
+	b := worker.NewPostgresStore(p)
+
+	id, err := a.Enqueue("refresh", nil, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expectLeased(t, a, time.Millisecond, id)
+	// The lease expires and the job is leased by another store.
+	time.Sleep(50 * time.Millisecond)
+	expectLeased(t, b, time.Minute, id)
+
+	if err = a.Complete(id); err != worker.ErrLeaseLost {
+		t.Errorf("expected ErrLeaseLost completing, got %v", err)
+	}
+	if err = a.Retry(id, errors.New("try again"), time.Now()); err != worker.ErrLeaseLost {
+		t.Errorf("expected ErrLeaseLost retrying, got %v", err)
+	}
+	if err = a.Fail(id, errors.New("broken")); err != worker.ErrLeaseLost {
+		t.Errorf("expected ErrLeaseLost failing, got %v", err)
+	}
+	if e, failed := jobState(t, p, id); e != "" || failed {
+		t.Errorf("expected job to be unchanged, got %q, %v", e, failed)
+	}
+	if err = b.Complete(id); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err = b.Complete(id); err != worker.ErrLeaseLost {
+		t.Errorf("expected ErrLeaseLost completing twice, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// A RetryPolicy describes how a failing job is retried.
+//
+// Each failed attempt is rescheduled after an exponentially increasing delay.
+// The delay for attempt n (starting at 1) is InitialBackoff * Multiplier^(n-1),
+// capped at MaxBackoff, and then reduced by a random amount of up to Jitter
+// percent to avoid many jobs retrying in lock-step.
+type RetryPolicy struct {
+	// Maximum number of times the job will be performed, including the first
+	// attempt. If zero, the job is retried until it succeeds.
+	MaxAttempts int
+
+	InitialBackoff time.Duration // Delay before the first retry.
+	MaxBackoff     time.Duration // Upper bound on the delay between retries, if non-zero.
+	Multiplier     float64       // Growth factor of the delay; defaults to 2.
+	Jitter         float64       // Fraction (0 to 1) of the delay to randomize.
+
+	// GiveUp, if set, is called with the last error and the number of attempts
+	// made once MaxAttempts is reached.
+	GiveUp func(err error, attempts int)
+}
+
+// DefaultRetryPolicy is a reasonable policy for jobs that talk to remote services.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 10 * time.Second,
+	MaxBackoff:     5 * time.Minute,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// Backoff returns the delay to wait before performing the given attempt again.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	mul := p.Multiplier
+	if mul < 1 {
+		mul = 2
+	}
+	d := float64(p.InitialBackoff) * math.Pow(mul, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		j := math.Min(p.Jitter, 1)
+		d -= d * j * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// exhausted returns true if no more attempts should be made after the given attempt.
+func (p RetryPolicy) exhausted(attempt int) bool {
+	return p.MaxAttempts > 0 && attempt >= p.MaxAttempts
+}
+
+// Retry wraps a job, rescheduling it according to the given policy each time
+// it returns an error.
+//
+// The returned job may be passed to Schedule, ScheduleAt, or RepeatEvery.
+func (s *Scheduler) Retry(j Job, p RetryPolicy) Job {
+	return withName(j, s.retry(j, p, func(_ context.Context, err error) error {
+		return err
+	}))
+}
+
+// RetryFunc is a convenience method for wrapping a bare func as a retried job.
+func (s *Scheduler) RetryFunc(j func() error, p RetryPolicy) Job {
+	return s.Retry(JobFunc(j), p)
+}
+
+// retry wraps j, rescheduling it according to p. The done func is called
+// exactly once per run of the returned job, either after a successful attempt
+// or after the policy gives up.
+//
+// Retries keep the identity of the job being performed, so a job canceled
+// between attempts is not retried.
+func (s *Scheduler) retry(j Job, p RetryPolicy, done func(context.Context, error) error) Job {
+	var attempt func(n int) Job
+	attempt = func(n int) Job {
+		return ContextJobFunc(func(ctx context.Context) error {
+			err := j.Perform(ctx)
+			if err == nil {
+				return done(ctx, nil)
+			}
+			if p.exhausted(n) {
+				if p.GiveUp != nil {
+					p.GiveUp(err, n)
+				}
+				return done(ctx, errors.Wrapf(err, "job failed after %d attempts, giving up", n))
+			}
+			d := p.Backoff(n)
+			if serr := s.reschedule(ctx, attempt(n+1), time.Now().Add(d), true); serr != nil {
+				return done(ctx, errors.Wrapf(err, "job failed on attempt %d and could not be retried: %s", n, serr.Error()))
+			}
+			return errors.Wrapf(err, "job failed on attempt %d, retrying in %s", n, d)
+		})
+	}
+	return attempt(1)
+}
+
+// A RepeatOption configures the behavior of a repeating job.
+type RepeatOption func(*repeatConfig)
+
+type repeatConfig struct {
+	continueOnError bool
+	retry           *RetryPolicy
+}
+
+// ContinueOnError causes a repeating job to be rescheduled even when it returns
+// an error.
+func ContinueOnError() RepeatOption {
+	return func(c *repeatConfig) {
+		c.continueOnError = true
+	}
+}
+
+// RetryWith causes a repeating job to be retried using the given policy when it
+// returns an error. The job is rescheduled for its next regular run once it
+// succeeds, or after the policy gives up if ContinueOnError is also given.
+func RetryWith(p RetryPolicy) RepeatOption {
+	return func(c *repeatConfig) {
+		c.retry = &p
+	}
+}
@@ -0,0 +1,180 @@
+package worker_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/motki/core/log"
+	"github.com/motki/core/worker"
+)
+
+// TestRetryPolicyBackoff tests the exponential backoff calculation.
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := worker.RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+	}
+	expected := []time.Duration{
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+	}
+	for i, e := range expected {
+		if d := p.Backoff(i + 1); d != e {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, e, d)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.Backoff(3); d > 4*time.Second || d < 2*time.Second {
+			t.Errorf("expected backoff between 2s and 4s, got %s", d)
+			return
+		}
+	}
+}
+
+// TestRetryGivesUp tests that a failing job is retried until the policy gives up.
+func TestRetryGivesUp(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	var calls int64
+	gaveUp := make(chan int)
+	p := worker.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: delay,
+		GiveUp: func(err error, attempts int) {
+			gaveUp <- attempts
+		},
+	}
+	err := sched.Schedule(sched.RetryFunc(func() error {
+		atomic.AddInt64(&calls, 1)
+		return errors.New("always fails")
+	}, p))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case n := <-gaveUp:
+		if n != 3 {
+			t.Errorf("expected to give up after 3 attempts, got %d", n)
+		}
+		if c := atomic.LoadInt64(&calls); c != 3 {
+			t.Errorf("expected job to be performed 3 times, got %d", c)
+		}
+
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not give up in time")
+	}
+}
+
+// TestRetrySucceeds tests that retrying stops once the job succeeds.
+func TestRetrySucceeds(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	var calls int64
+	done := make(chan struct{})
+	p := worker.RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: delay,
+		GiveUp: func(err error, attempts int) {
+			t.Errorf("unexpected give up after %d attempts", attempts)
+		},
+	}
+	err := sched.Schedule(sched.RetryFunc(func() error {
+		if atomic.AddInt64(&calls, 1) < 2 {
+			return errors.New("fails once")
+		}
+		close(done)
+		return nil
+	}, p))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case <-done:
+		return
+
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not succeed in time")
+	}
+}
+
+// TestRepeatEveryContinueOnError tests that a repeating job keeps running after errors.
+func TestRepeatEveryContinueOnError(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	q := make(chan struct{})
+
+	err := sched.Schedule(
+		sched.RepeatFuncEvery(
+			func() error {
+				q <- struct{}{}
+				return errors.New("error with running the job")
+			}, delay, worker.ContinueOnError()))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	for i := 0; i < 5; i++ {
+		select {
+		case <-q:
+			continue
+
+		case <-time.After(250 * time.Millisecond):
+			t.Error("did not process func in time")
+			return
+		}
+	}
+}
+
+// TestRepeatEveryRetryWith tests that a repeating job is retried and then
+// continues repeating.
+func TestRepeatEveryRetryWith(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	var calls int64
+	q := make(chan struct{})
+	p := worker.RetryPolicy{MaxAttempts: 2, InitialBackoff: delay}
+
+	err := sched.Schedule(
+		sched.RepeatFuncEvery(
+			func() error {
+				// Fail every other call; the retry always succeeds.
+				if atomic.AddInt64(&calls, 1)%2 == 1 {
+					return errors.New("error with running the job")
+				}
+				q <- struct{}{}
+				return nil
+			}, delay, worker.RetryWith(p)))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-q:
+			continue
+
+		case <-time.After(250 * time.Millisecond):
+			t.Error("did not process func in time")
+			return
+		}
+	}
+}
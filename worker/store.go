@@ -0,0 +1,211 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// A DurableJob is a Job that can be persisted in a Store and performed later,
+// possibly by a different process.
+//
+// The job's kind must be registered with RegisterKind on every scheduler that
+// may perform it.
+type DurableJob interface {
+	Job
+
+	// Kind returns the name the job's kind is registered with.
+	Kind() string
+
+	// MarshalPayload returns the serialized form of the job.
+	MarshalPayload() ([]byte, error)
+}
+
+// A KindFunc reconstructs a Job from its serialized payload.
+type KindFunc func(payload []byte) (Job, error)
+
+// A StoredJob is a job read from a Store.
+type StoredJob struct {
+	ID       int64
+	Kind     string
+	Payload  []byte
+	Attempts int // Number of times the job has been leased, including the current lease.
+	RunAt    time.Time
+}
+
+// A Store persists jobs so that they survive restarts and can be shared
+// between multiple processes.
+//
+// Delivery is at-least-once: a job that is leased but not completed or retried
+// within its visibility timeout becomes available to be leased again.
+// Complete, Retry and Fail return ErrLeaseLost if the job is no longer leased
+// by the caller, such as when it was leased again elsewhere after its
+// visibility timeout elapsed. The job is left unchanged.
+type Store interface {
+	// Enqueue persists a job to be performed at or after runAt.
+	Enqueue(kind string, payload []byte, runAt time.Time) (int64, error)
+
+	// Lease reserves up to n jobs that are ready to be performed. Leased jobs
+	// are hidden from other calls to Lease until the visibility timeout elapses.
+	Lease(n int, visibility time.Duration) ([]*StoredJob, error)
+
+	// Complete removes a successfully performed job from the store.
+	Complete(id int64) error
+
+	// Retry releases the lease on a failed job, making it available again at runAt.
+	Retry(id int64, err error, runAt time.Time) error
+
+	// Fail marks a job as permanently failed. It will not be leased again.
+	Fail(id int64, err error) error
+}
+
+// ErrLeaseLost is returned when completing, retrying or failing a job whose
+// lease is no longer held.
+var ErrLeaseLost = errors.New("job lease was lost")
+
+// ErrUnknownKind is returned when a durable job's kind has not been registered.
+var ErrUnknownKind = errors.New("job kind is not registered")
+
+// RegisterKind registers fn to reconstruct jobs of the given kind when they
+// are leased from the store.
+func (s *Scheduler) RegisterKind(kind string, fn KindFunc) {
+	s.storeMu.Lock()
+	defer s.storeMu.Unlock()
+	s.kinds[kind] = fn
+}
+
+// UseStore configures the scheduler to persist DurableJobs in the given store
+// and to perform jobs leased from it.
+//
+// Once a store is in use, Schedule and ScheduleAt persist any DurableJob
+// instead of holding it in memory. Other jobs are unaffected.
+//
+// Schedulers have no store by default, including the one created by
+// app.NewEnv. See PostgresStore.
+func (s *Scheduler) UseStore(st Store) error {
+	s.storeMu.Lock()
+	defer s.storeMu.Unlock()
+	if s.store != nil {
+		return errors.New("scheduler already has a store")
+	}
+	select {
+	case <-s.quit:
+		return ErrShutdown
+	default:
+	}
+	s.store = st
+	s.inc()
+	go func() {
+		defer s.dec()
+		s.loopStore(st)
+	}()
+	return nil
+}
+
+// durable returns the job as a DurableJob along with the store it should be
+// persisted in, if the scheduler has a store configured.
+func (s *Scheduler) durable(j Job) (DurableJob, Store, bool) {
+	d, ok := j.(DurableJob)
+	if !ok {
+		return nil, nil, false
+	}
+	s.storeMu.RLock()
+	defer s.storeMu.RUnlock()
+	if s.store == nil {
+		return nil, nil, false
+	}
+	return d, s.store, true
+}
+
+// persist adds the durable job to the store, to be performed at t.
+func (s *Scheduler) persist(d DurableJob, st Store, t time.Time) error {
+	s.storeMu.RLock()
+	_, ok := s.kinds[d.Kind()]
+	s.storeMu.RUnlock()
+	if !ok {
+		return errors.Wrap(ErrUnknownKind, d.Kind())
+	}
+	payload, err := d.MarshalPayload()
+	if err != nil {
+		return errors.Wrapf(err, "unable to marshal %s job", d.Kind())
+	}
+	_, err = st.Enqueue(d.Kind(), payload, t)
+	return err
+}
+
+// loopStore leases jobs from the store as workers become available.
+func (s *Scheduler) loopStore(st Store) {
+	tick := time.Tick(s.delay)
+	for {
+		select {
+		case <-s.quit:
+			// Quit signal received, return.
+			return
+
+		case <-tick:
+			n := cap(s.waiting) - len(s.waiting)
+			if cap(s.waiting) == 0 {
+				n = 1
+			}
+			if n <= 0 {
+				// No room for more work, wait until next tick.
+				continue
+			}
+			jobs, err := st.Lease(n, s.VisibilityTimeout)
+			if err != nil {
+				s.logger.Warnf("scheduler: unable to lease jobs from store: %s", err.Error())
+				continue
+			}
+			for _, sj := range jobs {
+				// Stored jobs are unnamed, so tracking them cannot fail.
+				e, _ := s.track(s.storedJob(st, sj))
+				e.queue(time.Now())
+				select {
+				case s.waiting <- e:
+				case <-s.quit:
+					// Jobs not yet performed will become visible again once
+					// their lease expires.
+					return
+				}
+			}
+		}
+	}
+}
+
+// storedJob returns a Job that performs the stored job and records the outcome
+// in the store.
+func (s *Scheduler) storedJob(st Store, sj *StoredJob) Job {
+	return ContextJobFunc(func(ctx context.Context) error {
+		s.storeMu.RLock()
+		fn, ok := s.kinds[sj.Kind]
+		s.storeMu.RUnlock()
+		var err error
+		if !ok {
+			err = errors.Wrap(ErrUnknownKind, sj.Kind)
+		} else {
+			var j Job
+			if j, err = fn(sj.Payload); err == nil {
+				err = j.Perform(ctx)
+			}
+		}
+		if err == nil {
+			return st.Complete(sj.ID)
+		}
+		p := s.StoreRetryPolicy
+		if p.exhausted(sj.Attempts) {
+			if p.GiveUp != nil {
+				p.GiveUp(err, sj.Attempts)
+			}
+			if ferr := st.Fail(sj.ID, err); ferr != nil {
+				s.logger.Warnf("scheduler: unable to mark job %d failed: %s", sj.ID, ferr.Error())
+			}
+			return errors.Wrapf(err, "%s job %d failed after %d attempts, giving up", sj.Kind, sj.ID, sj.Attempts)
+		}
+		d := p.Backoff(sj.Attempts)
+		if rerr := st.Retry(sj.ID, err, time.Now().Add(d)); rerr != nil {
+			s.logger.Warnf("scheduler: unable to reschedule job %d: %s", sj.ID, rerr.Error())
+		}
+		return errors.Wrapf(err, "%s job %d failed on attempt %d, retrying in %s", sj.Kind, sj.ID, sj.Attempts, d)
+	})
+}
@@ -0,0 +1,209 @@
+package worker_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/motki/core/log"
+	"github.com/motki/core/worker"
+)
+
+// memStore is an in-memory worker.Store used for testing.
+type memStore struct {
+	sync.Mutex
+	next int64
+	jobs map[int64]*memStoreJob
+
+	completed chan int64
+	failed    chan int64
+}
+
+type memStoreJob struct {
+	worker.StoredJob
+	leasedUntil time.Time
+	lastErr     error
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		jobs:      make(map[int64]*memStoreJob),
+		completed: make(chan int64, 10),
+		failed:    make(chan int64, 10),
+	}
+}
+
+func (s *memStore) Enqueue(kind string, payload []byte, runAt time.Time) (int64, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.next++
+	s.jobs[s.next] = &memStoreJob{StoredJob: worker.StoredJob{ID: s.next, Kind: kind, Payload: payload, RunAt: runAt}}
+	return s.next, nil
+}
+
+func (s *memStore) Lease(n int, visibility time.Duration) ([]*worker.StoredJob, error) {
+	s.Lock()
+	defer s.Unlock()
+	now := time.Now()
+	var res []*worker.StoredJob
+	for _, j := range s.jobs {
+		if len(res) >= n {
+			break
+		}
+		if j.RunAt.After(now) || j.leasedUntil.After(now) {
+			continue
+		}
+		j.Attempts++
+		j.leasedUntil = now.Add(visibility)
+		sj := j.StoredJob
+		res = append(res, &sj)
+	}
+	return res, nil
+}
+
+func (s *memStore) Complete(id int64) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.jobs, id)
+	s.completed <- id
+	return nil
+}
+
+func (s *memStore) Retry(id int64, err error, runAt time.Time) error {
+	s.Lock()
+	defer s.Unlock()
+	j := s.jobs[id]
+	j.RunAt = runAt
+	j.leasedUntil = time.Time{}
+	j.lastErr = err
+	return nil
+}
+
+func (s *memStore) Fail(id int64, err error) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.jobs, id)
+	s.failed <- id
+	return nil
+}
+
+// counterJob is a durable job that records its payload when performed.
+type counterJob struct {
+	n    int
+	seen chan int
+	fail bool
+}
+
+func (j counterJob) Perform(_ context.Context) error {
+	j.seen <- j.n
+	if j.fail {
+		return errors.New("counter job failed")
+	}
+	return nil
+}
+
+func (j counterJob) Kind() string {
+	return "counter"
+}
+
+func (j counterJob) MarshalPayload() ([]byte, error) {
+	return []byte(strconv.Itoa(j.n)), nil
+}
+
+// TestDurableJob tests that durable jobs are persisted and then performed.
+func TestDurableJob(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	seen := make(chan int, 1)
+	sched.RegisterKind("counter", func(payload []byte) (worker.Job, error) {
+		n, err := strconv.Atoi(string(payload))
+		if err != nil {
+			return nil, err
+		}
+		return counterJob{n: n, seen: seen}, nil
+	})
+	store := newMemStore()
+	if err := sched.UseStore(store); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	// The "seen" channel is not serialized, so the job performed must have
+	// been reconstructed from the store.
+	if err := sched.Schedule(counterJob{n: 42}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case n := <-seen:
+		if n != 42 {
+			t.Errorf("expected 42, got %d", n)
+		}
+
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not process job in time")
+		return
+	}
+
+	select {
+	case id := <-store.completed:
+		if id != 1 {
+			t.Errorf("expected job 1 to complete, got %d", id)
+		}
+
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not complete job in time")
+	}
+}
+
+// TestDurableJobFails tests that failing durable jobs are retried and then failed.
+func TestDurableJobFails(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	sched.StoreRetryPolicy = worker.RetryPolicy{MaxAttempts: 2, InitialBackoff: delay}
+	defer sched.Shutdown()
+
+	seen := make(chan int, 2)
+	sched.RegisterKind("counter", func(payload []byte) (worker.Job, error) {
+		return counterJob{seen: seen, fail: true}, nil
+	})
+	store := newMemStore()
+	if err := sched.UseStore(store); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if err := sched.Schedule(counterJob{}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	select {
+	case <-store.failed:
+		if len(seen) != 2 {
+			t.Errorf("expected 2 attempts, got %d", len(seen))
+		}
+
+	case <-time.After(250 * time.Millisecond):
+		t.Error("did not fail job in time")
+	}
+}
+
+// TestDurableJobUnknownKind tests that unregistered kinds cannot be scheduled.
+func TestDurableJobUnknownKind(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	defer sched.Shutdown()
+
+	if err := sched.UseStore(newMemStore()); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	err := sched.Schedule(counterJob{})
+	if errors.Cause(err) != worker.ErrUnknownKind {
+		t.Errorf("expected ErrUnknownKind, got %v", err)
+	}
+}
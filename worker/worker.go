@@ -1,4 +1,12 @@
 // Package worker provides a simple asynchronous worker queue.
+//
+// Jobs are held in memory by default. A Store may be configured to persist
+// jobs that implement DurableJob, allowing them to survive restarts and be
+// shared by multiple processes.
+//
+// Each scheduled job is tracked by the scheduler and may be given a name with
+// Named. Named jobs can be inspected, paused, resumed and canceled, and a job
+// cannot be scheduled while another job with the same name is pending.
 package worker
 
 import (
@@ -7,6 +15,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/net/context"
 
 	"github.com/motki/core/log"
 )
@@ -14,31 +23,75 @@ import (
 // Job defines the interface for performing asynchronous work.
 type Job interface {
 	// Perform invokes the job.
-	Perform() error
+	//
+	// The given context is canceled when the job is canceled or the scheduler
+	// shuts down. Long-running jobs should return promptly once it is done.
+	Perform(ctx context.Context) error
 }
 
 // JobFunc allows bare functions to implement the Job interface.
+//
+// The context passed to Perform is ignored; use ContextJobFunc for functions
+// that should be interruptible.
 type JobFunc func() error
 
-func (j JobFunc) Perform() error {
+func (j JobFunc) Perform(_ context.Context) error {
 	return j()
 }
 
+// ContextJobFunc allows bare functions accepting a context to implement the
+// Job interface.
+type ContextJobFunc func(ctx context.Context) error
+
+func (j ContextJobFunc) Perform(ctx context.Context) error {
+	return j(ctx)
+}
+
+// ErrQueueFull is returned when a job cannot be added to the waiting queue
+// before the scheduler's ScheduleTimeout elapses.
+var ErrQueueFull = errors.New("scheduler queue is full")
+
+// ErrShutdown is returned when a job is scheduled after the scheduler has begun
+// shutting down.
+var ErrShutdown = errors.New("scheduler is shutting down")
+
+const (
+	// DefaultPoolSize is the number of worker goroutines started by New and
+	// NewWithTick.
+	DefaultPoolSize = 1
+
+	// DefaultQueueSize is the number of jobs that may be waiting to be performed
+	// before Schedule begins to block.
+	DefaultQueueSize = 5
+)
+
 // Scheduler is the entry-point for scheduling jobs to run asynchronously.
 type Scheduler struct {
 	workers int64 // Number of active worker goroutines.
 
 	delay time.Duration // Delay between ticks.
 
-	waiting chan Job // Jobs ready to be performed.
+	waiting chan *entry // Jobs ready to be performed.
 
 	// Scheduled jobs are stored as slices truncated to "delay" intervals.
 	// For example, if delay is 5 * time.Millisecond, the scheduled map will
 	// contain a slice of jobs for every 5 milliseconds, rounded down.
-	scheduled  map[time.Time][]Job
+	scheduled  map[time.Time][]*entry
 	step       time.Time  // The current step in time.
 	schedMutex sync.Mutex // Guards scheduled and step.
 
+	store   Store               // Optional persistent job store.
+	kinds   map[string]KindFunc // Registered durable job kinds.
+	storeMu sync.RWMutex        // Guards store and kinds.
+
+	jobs   map[int64]*entry  // All tracked jobs, by ID.
+	names  map[string]*entry // Named jobs, by name.
+	nextID int64             // ID of the most recently tracked job.
+	jobsMu sync.Mutex        // Guards jobs, names, and nextID.
+
+	ctx    context.Context    // Parent of each job's context.
+	cancel context.CancelFunc // Cancels ctx when shutting down.
+
 	quit chan struct{} // Closed when shutting down.
 	done chan struct{} // Closed when finished shutting down.
 
@@ -47,6 +100,18 @@ type Scheduler struct {
 	// Acceptable time to wait before forcefully quitting when shutting down
 	// gracefully.
 	ShutdownTimeout time.Duration
+
+	// Maximum time Schedule will block waiting for room in the queue before
+	// giving up and returning ErrQueueFull. If zero, Schedule returns
+	// ErrQueueFull immediately when the queue is full.
+	ScheduleTimeout time.Duration
+
+	// Duration a job leased from the store is hidden from other schedulers.
+	// Jobs that run longer than this may be performed more than once.
+	VisibilityTimeout time.Duration
+
+	// Policy used to retry failed jobs leased from the store.
+	StoreRetryPolicy RetryPolicy
 }
 
 // New creates a new scheduler, ready to use.
@@ -56,15 +121,40 @@ func New(logger log.Logger) *Scheduler {
 
 // NewWithTick creates a new scheduler with a tick duration of delay.
 func NewWithTick(logger log.Logger, delay time.Duration) *Scheduler {
+	return NewWithPool(logger, delay, DefaultPoolSize, DefaultQueueSize)
+}
+
+// NewWithPool creates a new scheduler with a tick duration of delay, a pool
+// of size worker goroutines, and room for queueSize jobs waiting to be
+// performed.
+//
+// Each worker performs jobs as soon as they are available; the tick duration
+// only affects the granularity of jobs scheduled with ScheduleAt.
+func NewWithPool(logger log.Logger, delay time.Duration, size, queueSize int) *Scheduler {
+	if size < 1 {
+		size = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	ctx, cancel := context.WithCancel(context.Background())
 	s := &Scheduler{
 		delay: delay,
 
-		waiting: make(chan Job, 5),
+		waiting: make(chan *entry, queueSize),
 
-		scheduled:  make(map[time.Time][]Job),
+		scheduled:  make(map[time.Time][]*entry),
 		step:       time.Now().Truncate(delay),
 		schedMutex: sync.Mutex{},
 
+		kinds: make(map[string]KindFunc),
+
+		jobs:  make(map[int64]*entry),
+		names: make(map[string]*entry),
+
+		ctx:    ctx,
+		cancel: cancel,
+
 		quit: make(chan struct{}, 0),
 		done: make(chan struct{}, 0),
 
@@ -73,59 +163,209 @@ func NewWithTick(logger log.Logger, delay time.Duration) *Scheduler {
 		logger: logger,
 
 		ShutdownTimeout: 1 * time.Second,
+		ScheduleTimeout: 1 * time.Second,
+
+		VisibilityTimeout: 5 * time.Minute,
+		StoreRetryPolicy:  DefaultRetryPolicy,
+	}
+	// Increment the worker count before starting each goroutine so that an
+	// immediate Shutdown waits for all of them.
+	for i := 0; i < size; i++ {
+		s.inc()
+		go func() {
+			defer s.dec()
+			s.loop()
+		}()
 	}
-	go s.Loop()
-	go s.loopSchedule()
+	s.inc()
+	go func() {
+		defer s.dec()
+		s.loopSchedule()
+	}()
 	return s
 }
 
 // Schedule adds a job to be performed.
+//
+// If the queue is full, Schedule blocks for up to ScheduleTimeout waiting
+// for a worker to free up room. ErrQueueFull is returned if the job could
+// not be queued in time, and ErrShutdown is returned if the scheduler is
+// shutting down. ErrDuplicateJob is returned if the job is named and another
+// job with the same name is pending.
+//
+// If the scheduler has a store, a DurableJob is persisted instead.
 func (s *Scheduler) Schedule(j Job) error {
-	s.waiting <- j
+	select {
+	case <-s.quit:
+		return ErrShutdown
+	default:
+	}
+	if d, st, ok := s.durable(j); ok {
+		return s.persist(d, st, time.Now())
+	}
+	e, err := s.track(j)
+	if err != nil {
+		return err
+	}
+	e.queue(time.Now())
+	if err = s.enqueue(e); err != nil {
+		s.untrack(e)
+		return err
+	}
 	return nil
 }
 
+// enqueue adds the entry to the waiting queue, blocking for up to
+// ScheduleTimeout.
+func (s *Scheduler) enqueue(e *entry) error {
+	select {
+	case s.waiting <- e:
+		return nil
+	default:
+		// Queue is full, wait below.
+	}
+	if s.ScheduleTimeout <= 0 {
+		return ErrQueueFull
+	}
+	t := time.NewTimer(s.ScheduleTimeout)
+	defer t.Stop()
+	select {
+	case s.waiting <- e:
+		return nil
+	case <-s.quit:
+		return ErrShutdown
+	case <-t.C:
+		return ErrQueueFull
+	}
+}
+
 // ScheduleFunc is a convenience method accepting a function as a job.
 func (s *Scheduler) ScheduleFunc(j func() error) error {
-	s.waiting <- JobFunc(j)
-	return nil
+	return s.Schedule(JobFunc(j))
+}
+
+// Queued returns the number of jobs waiting to be performed.
+func (s *Scheduler) Queued() int {
+	return len(s.waiting)
 }
 
 // ScheduleAt adds a job to be performed at a specific time.
+//
+// If the scheduler has a store, a DurableJob is persisted instead.
 func (s *Scheduler) ScheduleAt(j Job, t time.Time) error {
+	if d, st, ok := s.durable(j); ok {
+		if !t.After(time.Now()) {
+			return errors.New("cannot schedule a job in the past")
+		}
+		return s.persist(d, st, t)
+	}
+	e, err := s.track(j)
+	if err != nil {
+		return err
+	}
+	if err = s.scheduleEntry(e, t, false); err != nil {
+		s.untrack(e)
+		return err
+	}
+	return nil
+}
+
+// ScheduleFuncAt is a convenience method for adding a bare func as a job.
+func (s *Scheduler) ScheduleFuncAt(j func() error, t time.Time) error {
+	return s.ScheduleAt(JobFunc(j), t)
+}
+
+// scheduleAfter adds a job to be performed once d has elapsed.
+//
+// Unlike ScheduleAt, durations shorter than the tick duration are rounded up
+// to the next step rather than returning an error.
+func (s *Scheduler) scheduleAfter(j Job, d time.Duration) error {
+	e, err := s.track(j)
+	if err != nil {
+		return err
+	}
+	return s.scheduleEntry(e, time.Now().Add(d), true)
+}
+
+// scheduleEntry adds the entry to be performed at t.
+//
+// If roundUp is true, times before the next step are moved to the next step.
+// Otherwise, an error is returned.
+func (s *Scheduler) scheduleEntry(e *entry, t time.Time, roundUp bool) error {
 	s.schedMutex.Lock()
 	defer s.schedMutex.Unlock()
 
-	t = t.Truncate(s.delay)
-	if !t.After(s.step) {
-		return errors.New("cannot schedule a job in the past")
+	st := t.Truncate(s.delay)
+	if !st.After(s.step) {
+		if !roundUp {
+			return errors.New("cannot schedule a job in the past")
+		}
+		st = s.step.Add(s.delay)
+		t = st
 	}
-	s.scheduled[t] = append(s.scheduled[t], j)
+	e.queue(t)
+	s.scheduled[st] = append(s.scheduled[st], e)
 
 	return nil
 }
 
-// ScheduleFuncAt is a convenience method for adding a bare func as a job.
-func (s *Scheduler) ScheduleFuncAt(j func() error, t time.Time) error {
-	return s.ScheduleAt(JobFunc(j), t)
+// reschedule adds j to be performed again at t.
+//
+// If ctx belongs to a job performed by the scheduler, j replaces that job
+// and keeps its identity. Canceled jobs are not rescheduled.
+func (s *Scheduler) reschedule(ctx context.Context, j Job, t time.Time, roundUp bool) error {
+	e := entryFromContext(ctx)
+	if e == nil {
+		if roundUp {
+			return s.scheduleAfter(j, t.Sub(time.Now()))
+		}
+		return s.ScheduleAt(j, t)
+	}
+	if !e.replace(j) {
+		return nil
+	}
+	return s.scheduleEntry(e, t, roundUp)
 }
 
 // RepeatEvery wraps a job, rescheduling it after each successful run.
-func (s *Scheduler) RepeatEvery(j Job, d time.Duration) Job {
-	var res Job
-	res = JobFunc(func() error {
-		err := j.Perform()
-		if err != nil {
-			return err
-		}
-		return s.ScheduleAt(res, time.Now().Add(d))
-	})
-	return res
+//
+// By default, a job that returns an error is not rescheduled. Use the
+// ContinueOnError and RetryWith options to change this behavior.
+func (s *Scheduler) RepeatEvery(j Job, d time.Duration, opts ...RepeatOption) Job {
+	return s.repeat(j, func() time.Time {
+		return time.Now().Add(d)
+	}, opts...)
 }
 
 // RepeatFuncEvery is a convenience method for wrapping a bare func as a repeated job.
-func (s *Scheduler) RepeatFuncEvery(j func() error, d time.Duration) Job {
-	return s.RepeatEvery(JobFunc(j), d)
+func (s *Scheduler) RepeatFuncEvery(j func() error, d time.Duration, opts ...RepeatOption) Job {
+	return s.RepeatEvery(JobFunc(j), d, opts...)
+}
+
+// repeat wraps a job, rescheduling it at the time returned by next after each run.
+func (s *Scheduler) repeat(j Job, next func() time.Time, opts ...RepeatOption) Job {
+	c := &repeatConfig{}
+	for _, o := range opts {
+		o(c)
+	}
+	var res Job
+	done := func(ctx context.Context, err error) error {
+		if err != nil && !c.continueOnError {
+			return err
+		}
+		if serr := s.reschedule(ctx, res, next(), false); serr != nil {
+			return serr
+		}
+		return err
+	}
+	if c.retry != nil {
+		res = s.retry(j, *c.retry, done)
+	} else {
+		res = ContextJobFunc(func(ctx context.Context) error {
+			return done(ctx, j.Perform(ctx))
+		})
+	}
+	return withName(j, res)
 }
 
 // inc atomically increments the number of workers.
@@ -151,8 +391,6 @@ func (s *Scheduler) dec() {
 
 // loopSchedule moves jobs to the waiting channel as their scheduled time is reached.
 func (s *Scheduler) loopSchedule() {
-	s.inc()
-	defer s.dec()
 	tick := time.Tick(s.delay)
 	for {
 		select {
@@ -171,9 +409,14 @@ func (s *Scheduler) loopSchedule() {
 				delete(s.scheduled, s.step)
 				s.schedMutex.Unlock()
 
-				// Move any jobs to the waiting channel.
-				for _, j := range jobs {
-					s.waiting <- j
+				// Move any jobs to the waiting channel, blocking until a
+				// worker makes room.
+				for _, e := range jobs {
+					select {
+					case s.waiting <- e:
+					case <-s.quit:
+						return
+					}
 				}
 
 				s.schedMutex.Lock()
@@ -186,34 +429,59 @@ func (s *Scheduler) loopSchedule() {
 }
 
 // Loop begins a worker goroutine that takes care of running any jobs.
+//
+// Loop blocks until the scheduler is shut down. Calling Loop adds an
+// additional worker to the pool started by the constructor.
 func (s *Scheduler) Loop() {
 	s.inc()
 	defer s.dec()
-	tick := time.Tick(s.delay)
+	s.loop()
+}
+
+// loop performs waiting jobs as soon as they are available.
+func (s *Scheduler) loop() {
 	for {
 		select {
 		case <-s.quit:
 			// Quit signal received, return.
 			return
 
-		case <-tick:
+		case e := <-s.waiting:
 			select {
-			case j := <-s.waiting:
-				// Received a waiting job, perform the work.
-				if err := j.Perform(); err != nil {
-					s.logger.Warnf("scheduler: job returned error: %s", err.Error())
-				}
+			case <-s.quit:
+				// Prefer quitting over starting more work.
+				return
 			default:
-				// nothing to work on, wait until next tick
 			}
+			// Received a waiting job, perform the work.
+			s.perform(e)
 		}
 	}
 }
 
+// perform performs the entry's job, unless it has been paused or canceled,
+// and records the outcome.
+func (s *Scheduler) perform(e *entry) {
+	j, ctx, cancel, ok := e.start(s.ctx)
+	if !ok {
+		return
+	}
+	defer cancel()
+	start := time.Now()
+	err := j.Perform(ctx)
+	if e.finish(start, err) {
+		s.retire(e)
+	}
+	if err != nil {
+		s.logger.Warnf("scheduler: job %s returned error: %s", e, err.Error())
+	}
+}
+
 // Shutdown performs a graceful shutdown of the scheduler.
 func (s *Scheduler) Shutdown() error {
-	// Signal workers to shutdown.
+	// Signal workers to shutdown and interrupt any running jobs.
 	close(s.quit)
+	s.cancel()
 	select {
 	case <-s.done:
 		// Block until workers decrements to 0 and the done channel is closed;
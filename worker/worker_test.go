@@ -234,3 +234,81 @@ func TestRepeatEvery(t *testing.T) {
 		}
 	}
 }
+
+// TestPool tests that a pool of workers performs jobs concurrently.
+func TestPool(t *testing.T) {
+	workers := 4
+	sched := worker.NewWithPool(log.New(log.Config{Level: "fatal"}), delay, workers, workers)
+	defer sched.Shutdown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		err := sched.ScheduleFunc(func() error {
+			started <- struct{}{}
+			<-release
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+	}
+	defer close(release)
+
+	for i := 0; i < workers; i++ {
+		select {
+		case <-started:
+			continue
+
+		case <-time.After(250 * time.Millisecond):
+			t.Errorf("expected %d concurrent jobs, got %d", workers, i)
+			return
+		}
+	}
+}
+
+// TestScheduleQueueFull tests that Schedule returns an error rather than
+// blocking indefinitely when the queue is full.
+func TestScheduleQueueFull(t *testing.T) {
+	sched := worker.NewWithPool(log.New(log.Config{Level: "fatal"}), delay, 1, 1)
+	sched.ScheduleTimeout = 10 * time.Millisecond
+	defer sched.Shutdown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	block := func() error {
+		close(started)
+		<-release
+		return nil
+	}
+	if err := sched.ScheduleFunc(block); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	<-started
+
+	// The only worker is busy, so this job fills the queue.
+	if err := sched.ScheduleFunc(func() error { return nil }); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if n := sched.Queued(); n != 1 {
+		t.Errorf("expected 1 queued job, got %d", n)
+	}
+	if err := sched.ScheduleFunc(func() error { return nil }); err != worker.ErrQueueFull {
+		t.Errorf("expected ErrQueueFull, got %v", err)
+	}
+}
+
+// TestScheduleAfterShutdown tests that scheduling on a stopped scheduler fails.
+func TestScheduleAfterShutdown(t *testing.T) {
+	sched := worker.NewWithTick(log.New(log.Config{Level: "fatal"}), delay)
+	if err := sched.Shutdown(); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	if err := sched.ScheduleFunc(func() error { return nil }); err != worker.ErrShutdown {
+		t.Errorf("expected ErrShutdown, got %v", err)
+	}
+}